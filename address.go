@@ -0,0 +1,352 @@
+package isocodes
+
+import (
+	"strings"
+)
+
+// Address represents a structured postal address.
+type Address struct {
+	Country           CountryCode `json:"country"`
+	Name              string      `json:"name,omitempty"`
+	Organization      string      `json:"organization,omitempty"`
+	StreetLines       []string    `json:"streetLines,omitempty"`
+	DependentLocality string      `json:"dependentLocality,omitempty"`
+	City              string      `json:"city,omitempty"`
+	Subdivision       string      `json:"subdivision,omitempty"`
+	PostalCode        string      `json:"postalCode,omitempty"`
+	SortingCode       string      `json:"sortingCode,omitempty"`
+}
+
+// AddressField represents a field of the Address.
+// Values are the placeholders used by AddressFormat templates.
+type AddressField byte
+
+const (
+	// AddressName represents the recipient name field.
+	AddressName AddressField = 'N'
+
+	// AddressOrganization represents the organization field.
+	AddressOrganization AddressField = 'O'
+
+	// AddressStreet represents the street lines field.
+	AddressStreet AddressField = 'A'
+
+	// AddressDependentLocality represents the dependent locality field,
+	// e.g. a district or a neighbourhood.
+	AddressDependentLocality AddressField = 'D'
+
+	// AddressCity represents the city field.
+	AddressCity AddressField = 'C'
+
+	// AddressSubdivision represents the country subdivision field,
+	// e.g. a state, a province or a prefecture.
+	AddressSubdivision AddressField = 'S'
+
+	// AddressPostalCode represents the postal code field.
+	AddressPostalCode AddressField = 'Z'
+
+	// AddressSortingCode represents the sorting code field, e.g. French CEDEX.
+	AddressSortingCode AddressField = 'X'
+)
+
+// String returns a human-readable name of the field.
+func (f AddressField) String() string {
+	switch f {
+	case AddressName:
+		return "name"
+	case AddressOrganization:
+		return "organization"
+	case AddressStreet:
+		return "street address"
+	case AddressDependentLocality:
+		return "dependent locality"
+	case AddressCity:
+		return "city"
+	case AddressSubdivision:
+		return "subdivision"
+	case AddressPostalCode:
+		return "postal code"
+	case AddressSortingCode:
+		return "sorting code"
+	default:
+		return "unknown"
+	}
+}
+
+// AddressFormat represents the postal address format of the country.
+type AddressFormat struct {
+	// Template holds the address layout, where %N, %O, %A, %D, %C, %S,
+	// %Z and %X are placeholders of AddressField values and %n is a line break.
+	Template string `json:"template"`
+
+	// Required holds fields which must be present in the address.
+	Required []AddressField `json:"required"`
+
+	// Upper holds fields which are written in upper case.
+	Upper []AddressField `json:"upper"`
+
+	// SubdivisionLabel holds the local name of the subdivision
+	// field, e.g. "State", "Prefecture" or "Oblast".
+	SubdivisionLabel string `json:"subdivisionLabel"`
+
+	// PostalCodeLabel holds the local name of the postal code field, e.g. "ZIP code".
+	PostalCodeLabel string `json:"postalCodeLabel"`
+}
+
+// AddressFormat returns the postal address format of the country.
+// For countries without specific format it returns the default format.
+func (c CountryCode) AddressFormat() AddressFormat {
+	format, ok := countryAddressFormats[c]
+	if !ok {
+		format = defaultAddressFormat
+	}
+
+	// Slices are copied, so callers can't modify the shared formats.
+	format.Upper = append([]AddressField(nil), format.Upper...)
+
+	if c.HasPostalCodes() {
+		format.Required = append([]AddressField(nil), format.Required...)
+	} else {
+		format.Required = removeField(format.Required, AddressPostalCode)
+	}
+
+	return format
+}
+
+// FormatAddress renders the address into lines of the local layout of the
+// address country. Empty fields are skipped together with their separators
+// and the fields which are written in upper case in the country are converted.
+func FormatAddress(a Address) []string {
+	format := a.Country.AddressFormat()
+	lines := make([]string, 0, len(a.StreetLines)+4)
+
+	for _, tmpl := range strings.Split(format.Template, "%n") {
+		for _, line := range renderAddressLine(tmpl, a, format) {
+			if line = strings.Join(strings.Fields(line), " "); line != "" {
+				lines = append(lines, line)
+			}
+		}
+	}
+
+	return lines
+}
+
+// FormatInternationalAddress renders the address like FormatAddress
+// and adds the country name in upper case as the last line,
+// as recommended for the international mail.
+func FormatInternationalAddress(a Address) []string {
+	lines := FormatAddress(a)
+
+	if name := a.Country.Name(); name != "" {
+		lines = append(lines, strings.ToUpper(name))
+	}
+
+	return lines
+}
+
+// ValidateAddress reports required fields of the address
+// format of the country which are missing in the address.
+//
+// Missing fields cause *AddressError which wraps ErrMissingAddressFields.
+func ValidateAddress(a Address) error {
+	var missing []AddressField
+
+	for _, f := range a.Country.AddressFormat().Required {
+		if strings.TrimSpace(a.field(f)) == "" {
+			missing = append(missing, f)
+		}
+	}
+
+	if len(missing) > 0 {
+		return &AddressError{Country: a.Country, Missing: missing}
+	}
+
+	return nil
+}
+
+// AddressError represents an error of the address validation.
+type AddressError struct {
+	Country CountryCode
+	Missing []AddressField
+}
+
+// Error implements error interface.
+func (e *AddressError) Error() string {
+	names := make([]string, len(e.Missing))
+	for i, f := range e.Missing {
+		names[i] = f.String()
+	}
+
+	return ErrMissingAddressFields.Error() + ": " + strings.Join(names, ", ")
+}
+
+// Unwrap returns ErrMissingAddressFields.
+func (e *AddressError) Unwrap() error { return ErrMissingAddressFields }
+
+// field returns the value of the address field.
+// Street lines are joined with line breaks.
+func (a Address) field(f AddressField) string {
+	switch f {
+	case AddressName:
+		return a.Name
+	case AddressOrganization:
+		return a.Organization
+	case AddressStreet:
+		return strings.Join(a.StreetLines, "\n")
+	case AddressDependentLocality:
+		return a.DependentLocality
+	case AddressCity:
+		return a.City
+	case AddressSubdivision:
+		return a.Subdivision
+	case AddressPostalCode:
+		return a.PostalCode
+	case AddressSortingCode:
+		return a.SortingCode
+	default:
+		return ""
+	}
+}
+
+// addressToken represents a literal or a field placeholder of the template line.
+type addressToken struct {
+	field   AddressField
+	literal string
+}
+
+// renderAddressLine renders the template line. The literal preceding an empty
+// field is dropped, or the following one when the field starts the line.
+// It returns multiple lines when the street address has several lines.
+func renderAddressLine(tmpl string, a Address, format AddressFormat) []string {
+	var tokens []addressToken
+
+	for i := 0; i < len(tmpl); i++ {
+		if tmpl[i] == '%' && i+1 < len(tmpl) {
+			tokens = append(tokens, addressToken{field: AddressField(tmpl[i+1])})
+			i++
+
+			continue
+		}
+
+		if n := len(tokens); n > 0 && tokens[n-1].field == 0 {
+			tokens[n-1].literal += tmpl[i : i+1]
+		} else {
+			tokens = append(tokens, addressToken{literal: tmpl[i : i+1]})
+		}
+	}
+
+	values := make([]string, len(tokens))
+	drop := make([]bool, len(tokens))
+
+	for i, t := range tokens {
+		if t.field == 0 {
+			continue
+		}
+
+		values[i] = strings.TrimSpace(a.field(t.field))
+		if containsField(format.Upper, t.field) {
+			values[i] = strings.ToUpper(values[i])
+		}
+
+		if values[i] != "" {
+			continue
+		}
+
+		switch {
+		case i > 0 && tokens[i-1].field == 0:
+			drop[i-1] = true
+		case i+1 < len(tokens) && tokens[i+1].field == 0:
+			drop[i+1] = true
+		}
+	}
+
+	var b strings.Builder
+
+	for i, t := range tokens {
+		switch {
+		case drop[i]:
+		case t.field == 0:
+			b.WriteString(t.literal)
+		default:
+			b.WriteString(values[i])
+		}
+	}
+
+	return strings.Split(b.String(), "\n")
+}
+
+// containsField reports whether fields contain the field.
+func containsField(fields []AddressField, f AddressField) bool {
+	for _, field := range fields {
+		if field == f {
+			return true
+		}
+	}
+
+	return false
+}
+
+// removeField returns fields without the field.
+func removeField(fields []AddressField, f AddressField) []AddressField {
+	result := make([]AddressField, 0, len(fields))
+
+	for _, field := range fields {
+		if field != f {
+			result = append(result, field)
+		}
+	}
+
+	return result
+}
+
+// Shorthands of the address fields used by countryAddressFormats.
+const (
+	fA = AddressStreet
+	fC = AddressCity
+	fS = AddressSubdivision
+	fZ = AddressPostalCode
+	fX = AddressSortingCode
+)
+
+// defaultAddressFormat holds the address format of countries without specific format.
+var defaultAddressFormat = AddressFormat{
+	Template:         "%N%n%O%n%A%n%Z %C",
+	Required:         []AddressField{fA, fC, fZ},
+	Upper:            []AddressField{fC},
+	SubdivisionLabel: "Province",
+	PostalCodeLabel:  "Postal code",
+}
+
+// countryAddressFormats holds address formats of countries,
+// which are based on the Universal Postal Union addressing guides.
+var countryAddressFormats = map[CountryCode]AddressFormat{
+	AR: {"%N%n%O%n%A%n%Z %C%n%S", []AddressField{fA, fC}, []AddressField{fA, fC, fS}, "Province", "Postal code"},
+	AT: {"%O%n%N%n%A%n%Z %C", []AddressField{fA, fC, fZ}, nil, "State", "Postal code"},
+	AU: {"%O%n%N%n%A%n%C %S %Z", []AddressField{fA, fC, fS, fZ}, []AddressField{fC, fS}, "State", "Postcode"},
+	BE: {"%O%n%N%n%A%n%Z %C", []AddressField{fA, fC, fZ}, nil, "Province", "Postal code"},
+	BR: {"%O%n%N%n%A%n%D%n%C-%S%n%Z", []AddressField{fA, fC, fS, fZ}, []AddressField{fC, fS}, "State", "CEP"},
+	CA: {"%N%n%O%n%A%n%C %S %Z", []AddressField{fA, fC, fS, fZ}, []AddressField{fA, fC, fS, fZ}, "Province", "Postal code"},
+	CH: {"%O%n%N%n%A%n%Z %C", []AddressField{fA, fC, fZ}, nil, "Canton", "Postal code"},
+	CN: {"%Z%n%S%C%D%n%A%n%O%n%N", []AddressField{fA, fC, fS}, nil, "Province", "Postal code"},
+	DE: {"%N%n%O%n%A%n%Z %C", []AddressField{fA, fC, fZ}, nil, "State", "Postal code"},
+	DK: {"%N%n%O%n%A%n%Z %C", []AddressField{fA, fC, fZ}, nil, "Region", "Postal code"},
+	ES: {"%N%n%O%n%A%n%Z %C %S", []AddressField{fA, fC, fS, fZ}, []AddressField{fC, fS}, "Province", "Postal code"},
+	FI: {"%O%n%N%n%A%n%Z %C", []AddressField{fA, fC, fZ}, []AddressField{fC}, "Region", "Postal code"},
+	FR: {"%O%n%N%n%A%n%Z %C %X", []AddressField{fA, fC, fZ}, []AddressField{fC, fX}, "Department", "Postal code"},
+	GB: {"%N%n%O%n%A%n%C%n%Z", []AddressField{fA, fC, fZ}, []AddressField{fC, fZ}, "County", "Postcode"},
+	IE: {"%N%n%O%n%A%n%D%n%C%n%S%n%Z", []AddressField{fA}, []AddressField{fZ}, "County", "Eircode"},
+	IN: {"%N%n%O%n%A%n%C %Z%n%S", []AddressField{fA, fC, fS, fZ}, nil, "State", "PIN code"},
+	IT: {"%N%n%O%n%A%n%Z %C %S", []AddressField{fA, fC, fS, fZ}, []AddressField{fC, fS}, "Province", "Postal code"},
+	JP: {"〒%Z%n%S%C%n%A%n%O%n%N", []AddressField{fA, fS, fZ}, []AddressField{fS}, "Prefecture", "Postal code"},
+	KR: {"%S %C%D%n%A%n%O%n%N%n%Z", []AddressField{fA, fC, fS, fZ}, []AddressField{fS}, "Province", "Postal code"},
+	MX: {"%N%n%O%n%A%n%D%n%Z %C, %S", []AddressField{fA, fC, fZ}, []AddressField{fA, fC, fS}, "State", "Postal code"},
+	NL: {"%O%n%N%n%A%n%Z %C", []AddressField{fA, fC, fZ}, nil, "Province", "Postal code"},
+	NO: {"%N%n%O%n%A%n%Z %C", []AddressField{fA, fC, fZ}, nil, "County", "Postal code"},
+	PL: {"%N%n%O%n%A%n%Z %C", []AddressField{fA, fC, fZ}, nil, "Voivodeship", "Postal code"},
+	PT: {"%N%n%O%n%A%n%Z %C", []AddressField{fA, fC, fZ}, nil, "District", "Postal code"},
+	RU: {"%N%n%O%n%A%n%C%n%S%n%Z", []AddressField{fA, fC, fS, fZ}, []AddressField{fA, fC, fS}, "Oblast", "Postal code"},
+	SE: {"%O%n%N%n%A%n%Z %C", []AddressField{fA, fC, fZ}, []AddressField{fC}, "County", "Postal code"},
+	TR: {"%N%n%O%n%A%n%Z %C/%S", []AddressField{fA, fC, fZ}, nil, "Province", "Postal code"},
+	UA: {"%N%n%O%n%A%n%C%n%S%n%Z", []AddressField{fA, fC, fZ}, nil, "Oblast", "Postal code"},
+	US: {"%N%n%O%n%A%n%C, %S %Z", []AddressField{fA, fC, fS, fZ}, []AddressField{fC, fS}, "State", "ZIP code"},
+}
@@ -0,0 +1,134 @@
+package isocodes
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestFormatAddress(t *testing.T) {
+	type tcase struct {
+		address Address
+		want    []string
+	}
+
+	tests := map[string]tcase{
+		"US": {
+			Address{Country: US, Name: "John Smith", StreetLines: []string{"1600 Pennsylvania Ave NW"}, City: "Washington", Subdivision: "dc", PostalCode: "20500"},
+			[]string{"John Smith", "1600 Pennsylvania Ave NW", "WASHINGTON, DC 20500"},
+		},
+		"GB": {
+			Address{Country: GB, Name: "Jane Doe", Organization: "Acme Ltd", StreetLines: []string{"10 Downing Street"}, City: "London", PostalCode: "sw1a 2aa"},
+			[]string{"Jane Doe", "Acme Ltd", "10 Downing Street", "LONDON", "SW1A 2AA"},
+		},
+		"DE": {
+			Address{Country: DE, Name: "Max Mustermann", StreetLines: []string{"Musterstraße 1", "Hinterhaus"}, City: "Berlin", PostalCode: "10115"},
+			[]string{"Max Mustermann", "Musterstraße 1", "Hinterhaus", "10115 Berlin"},
+		},
+		"FRCedex": {
+			Address{Country: FR, Organization: "Société", Name: "Marie Curie", StreetLines: []string{"1 Rue de Rivoli"}, City: "Paris", PostalCode: "75001", SortingCode: "Cedex 01"},
+			[]string{"Société", "Marie Curie", "1 Rue de Rivoli", "75001 PARIS CEDEX 01"},
+		},
+		"JP": {
+			Address{Country: JP, Name: "山田太郎", StreetLines: []string{"千代田1-1"}, City: "千代田区", Subdivision: "東京都", PostalCode: "100-0001"},
+			[]string{"〒100-0001", "東京都千代田区", "千代田1-1", "山田太郎"},
+		},
+		"BRMissingState": {
+			Address{Country: BR, Name: "João", StreetLines: []string{"Av. Paulista, 1000"}, City: "São Paulo", PostalCode: "01310-100"},
+			[]string{"João", "Av. Paulista, 1000", "SÃO PAULO", "01310-100"},
+		},
+		"Default": {
+			Address{Country: BT, Name: "Karma", StreetLines: []string{"Norzin Lam"}, City: "Thimphu"},
+			[]string{"Karma", "Norzin Lam", "THIMPHU"},
+		},
+		"DefaultPostalCode": {
+			Address{Country: GR, Name: "Jane", StreetLines: []string{"1 Main St"}, City: "Town", PostalCode: "105 57"},
+			[]string{"Jane", "1 Main St", "105 57 TOWN"},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := FormatAddress(tc.address); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("FormatAddress() got = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestFormatInternationalAddress(t *testing.T) {
+	a := Address{Country: NL, Name: "Anna", StreetLines: []string{"Damrak 1"}, City: "Amsterdam", PostalCode: "1012 LG"}
+	want := []string{"Anna", "Damrak 1", "1012 LG Amsterdam", "NETHERLANDS"}
+
+	if got := FormatInternationalAddress(a); !reflect.DeepEqual(got, want) {
+		t.Errorf("FormatInternationalAddress() got = %q, want %q", got, want)
+	}
+}
+
+func TestValidateAddress(t *testing.T) {
+	type tcase struct {
+		address Address
+		missing []AddressField
+	}
+
+	tests := map[string]tcase{
+		"Valid":    {Address{Country: DE, StreetLines: []string{"Musterstraße 1"}, City: "Berlin", PostalCode: "10115"}, nil},
+		"US":       {Address{Country: US, StreetLines: []string{"1 Main St"}, City: "Springfield"}, []AddressField{AddressSubdivision, AddressPostalCode}},
+		"Street":   {Address{Country: GB, StreetLines: []string{" "}, City: "London", PostalCode: "SW1A 1AA"}, []AddressField{AddressStreet}},
+		"NoPostal": {Address{Country: AE, StreetLines: []string{"Sheikh Zayed Rd"}, City: "Dubai"}, nil},
+		"Default":  {Address{Country: GR, StreetLines: []string{"1 Main St"}, City: "Athens"}, []AddressField{AddressPostalCode}},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateAddress(tc.address)
+			if tc.missing == nil {
+				if err != nil {
+					t.Errorf("ValidateAddress() unexpected error = %v", err)
+				}
+
+				return
+			}
+
+			var addrErr *AddressError
+			if !errors.As(err, &addrErr) || !errors.Is(err, ErrMissingAddressFields) {
+				t.Fatalf("ValidateAddress() error = %v, want *AddressError", err)
+			}
+
+			if !reflect.DeepEqual(addrErr.Missing, tc.missing) {
+				t.Errorf("ValidateAddress() missing = %v, want %v", addrErr.Missing, tc.missing)
+			}
+		})
+	}
+
+	want := "missing required address fields: subdivision, postal code"
+	if err := ValidateAddress(tests["US"].address); err.Error() != want {
+		t.Errorf("Error() got = %v, want %v", err, want)
+	}
+}
+
+func TestCountryCode_AddressFormat(t *testing.T) {
+	if got := US.AddressFormat().SubdivisionLabel; got != "State" {
+		t.Errorf("SubdivisionLabel got = %v, want State", got)
+	}
+
+	if got := JP.AddressFormat().SubdivisionLabel; got != "Prefecture" {
+		t.Errorf("SubdivisionLabel got = %v, want Prefecture", got)
+	}
+
+	if got := RU.AddressFormat().SubdivisionLabel; got != "Oblast" {
+		t.Errorf("SubdivisionLabel got = %v, want Oblast", got)
+	}
+
+	if got := BT.AddressFormat(); got.Template != defaultAddressFormat.Template {
+		t.Errorf("AddressFormat() got = %v, want default", got)
+	}
+
+	modified := US.AddressFormat()
+	modified.Required[0] = AddressSortingCode
+	modified.Upper[0] = AddressSortingCode
+
+	if got := US.AddressFormat(); got.Required[0] == AddressSortingCode || got.Upper[0] == AddressSortingCode {
+		t.Errorf("AddressFormat() got = %v after modification of the result", got)
+	}
+}
@@ -0,0 +1,145 @@
+package isocodes
+
+import (
+	"database/sql/driver"
+	"strings"
+)
+
+// BIC represents ISO 9362 Business Identifier Code, also known
+// as SWIFT code, e.g. "DEUTDEFF" or "DEUTDEFF500".
+// Use ParseBIC to obtain a valid BIC from the user input.
+type BIC string
+
+// bicKosovo holds the reserved code used by SWIFT for Kosovo,
+// which isn't assigned by ISO 3166-1.
+const bicKosovo = "XK"
+
+// ParseBIC takes case-insensitive 8 or 11 characters BIC, validates
+// its structure and the country code and returns the upper case BIC.
+// Besides ISO 3166-1 codes the country code may be the reserved XK code
+// of Kosovo, which is used by SWIFT.
+//
+// Invalid BICs cause *ParseError which wraps ErrInvalidBIC.
+func ParseBIC(s string) (BIC, error) {
+	bic := strings.ToUpper(s)
+
+	if len(bic) != 8 && len(bic) != 11 {
+		return "", bicParseError(s)
+	}
+
+	if !isAlphanumeric(bic[:4]) || !isUpperLetters(bic[4:6]) || !isAlphanumeric(bic[6:]) {
+		return "", bicParseError(s)
+	}
+
+	if _, ok := CountryCode(0).lookup(bic[4:6]); !ok && bic[4:6] != bicKosovo {
+		return "", bicParseError(s)
+	}
+
+	return BIC(bic), nil
+}
+
+// String returns string representation of the BIC.
+func (b BIC) String() string { return string(b) }
+
+// IsValid reports whether the BIC is valid.
+func (b BIC) IsValid() bool {
+	_, err := ParseBIC(string(b))
+
+	return err == nil
+}
+
+// Institution returns the 4 characters institution (business party prefix) code.
+func (b BIC) Institution() string { return b.part(0, 4) }
+
+// CountryAlpha2 returns the Alpha2 country code of the BIC as is, including XK.
+func (b BIC) CountryAlpha2() string { return b.part(4, 6) }
+
+// Country returns the country of the BIC. For the reserved XK code of Kosovo
+// it returns UnknownCountry unless the code is registered by RegisterCountryCode.
+func (b BIC) Country() CountryCode {
+	c, ok := CountryCode(0).lookup(b.CountryAlpha2())
+	if !ok {
+		return UnknownCountry
+	}
+
+	return CountryCode(c)
+}
+
+// Location returns the 2 characters location (business party suffix) code.
+func (b BIC) Location() string { return b.part(6, 8) }
+
+// Branch returns the 3 characters branch code. For 8 characters BIC,
+// which identifies the primary office, it returns "XXX".
+func (b BIC) Branch() string {
+	if len(b) == 8 {
+		return bicPrimaryOffice
+	}
+
+	return b.part(8, 11)
+}
+
+// IsPrimaryOffice reports whether the BIC identifies the primary office
+// of the institution rather than a branch.
+func (b BIC) IsPrimaryOffice() bool { return b.Branch() == bicPrimaryOffice }
+
+// IsTest reports whether the BIC is a test BIC, which is
+// indicated by '0' as the second character of the location code.
+func (b BIC) IsTest() bool {
+	location := b.Location()
+
+	return len(location) == 2 && location[1] == '0'
+}
+
+// MarshalJSON implements json.Marshaler.
+// The zero value is marshalled as JSON null.
+func (b BIC) MarshalJSON() ([]byte, error) { return marshalIdentifierJSON(b) }
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (b *BIC) UnmarshalJSON(data []byte) error { return unmarshalIdentifierJSON(b, data, ParseBIC) }
+
+// MarshalText implements encoding.TextMarshaler.
+// The zero value is marshalled as an empty text.
+func (b BIC) MarshalText() ([]byte, error) { return marshalIdentifierText(b) }
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// An empty text is unmarshalled into the zero value.
+func (b *BIC) UnmarshalText(data []byte) error { return parseIdentifier(b, string(data), ParseBIC) }
+
+// Scan implements sql.Scanner.
+// SQL NULL and an empty string are scanned into the zero value.
+func (b *BIC) Scan(src any) error { return scanIdentifierSQL(b, src, ParseBIC) }
+
+// Value implements driver.Valuer.
+// The zero value is stored as SQL NULL.
+func (b BIC) Value() (driver.Value, error) { return valueIdentifierSQL(b) }
+
+// bicPrimaryOffice holds the branch code of the primary office.
+const bicPrimaryOffice = "XXX"
+
+// part returns the part of the BIC or an empty string for malformed BIC.
+func (b BIC) part(start, end int) string {
+	if len(b) < end {
+		return ""
+	}
+
+	return string(b[start:end])
+}
+
+// parseError returns ParseError for the invalid BIC with the error.
+func (BIC) parseError(input string, err error) *ParseError {
+	return &ParseError{Input: input, Type: CodeTypeBIC, Format: FormatISO9362, Err: err}
+}
+
+// bicParseError returns ParseError for the invalid BIC.
+func bicParseError(input string) *ParseError { return BIC("").parseError(input, ErrInvalidBIC) }
+
+// isAlphanumeric reports whether s consists of ASCII digits and upper case letters only.
+func isAlphanumeric(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if (s[i] < '0' || s[i] > '9') && (s[i] < 'A' || s[i] > 'Z') {
+			return false
+		}
+	}
+
+	return true
+}
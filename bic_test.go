@@ -0,0 +1,140 @@
+package isocodes
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestParseBIC(t *testing.T) {
+	type tcase struct {
+		input   string
+		want    BIC
+		wantErr error
+	}
+
+	tests := map[string]tcase{
+		"Eight":          {"DEUTDEFF", "DEUTDEFF", nil},
+		"Eleven":         {"DEUTDEFF500", "DEUTDEFF500", nil},
+		"LowerCase":      {"nwbkgb2l", "NWBKGB2L", nil},
+		"Kosovo":         {"RBKOXKPR", "RBKOXKPR", nil},
+		"Length":         {"DEUTDEF", "", ErrInvalidBIC},
+		"LengthTen":      {"DEUTDEFF50", "", ErrInvalidBIC},
+		"UnknownCountry": {"DEUTQQFF", "", ErrInvalidBIC},
+		"DigitsCountry":  {"DEUT12FF", "", ErrInvalidBIC},
+		"Symbols":        {"DE-TDEFF", "", ErrInvalidBIC},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseBIC(tc.input)
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Errorf("ParseBIC() error = %v, wantErr %v", err, tc.wantErr)
+				}
+			} else {
+				if err != nil || got != tc.want {
+					t.Errorf("ParseBIC() got = %v, %v, want %v", got, err, tc.want)
+				}
+			}
+		})
+	}
+}
+
+func TestBIC_Parts(t *testing.T) {
+	type tcase struct {
+		bic         BIC
+		institution string
+		country     CountryCode
+		location    string
+		branch      string
+		primary     bool
+		test        bool
+	}
+
+	tests := map[string]tcase{
+		"Primary": {"DEUTDEFF", "DEUT", DE, "FF", "XXX", true, false},
+		"Branch":  {"DEUTDEFF500", "DEUT", DE, "FF", "500", false, false},
+		"XXX":     {"NWBKGB2LXXX", "NWBK", GB, "2L", "XXX", true, false},
+		"Test":    {"BOFAUS30", "BOFA", US, "30", "XXX", true, true},
+		"Kosovo":  {"RBKOXKPR", "RBKO", UnknownCountry, "PR", "XXX", true, false},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := tc.bic.Institution(); got != tc.institution {
+				t.Errorf("Institution() got = %v, want %v", got, tc.institution)
+			}
+
+			if got := tc.bic.Country(); got != tc.country {
+				t.Errorf("Country() got = %v, want %v", got, tc.country)
+			}
+
+			if got := tc.bic.Location(); got != tc.location {
+				t.Errorf("Location() got = %v, want %v", got, tc.location)
+			}
+
+			if got := tc.bic.Branch(); got != tc.branch {
+				t.Errorf("Branch() got = %v, want %v", got, tc.branch)
+			}
+
+			if got := tc.bic.IsPrimaryOffice(); got != tc.primary {
+				t.Errorf("IsPrimaryOffice() got = %v, want %v", got, tc.primary)
+			}
+
+			if got := tc.bic.IsTest(); got != tc.test {
+				t.Errorf("IsTest() got = %v, want %v", got, tc.test)
+			}
+		})
+	}
+
+	t.Run("RegisteredKosovo", func(t *testing.T) {
+		resetRegistries(t)
+
+		xk, err := RegisterCountryCode(CountryCodeDetails{Alpha2: "XK", Alpha3: "XKX", Name: "Kosovo"})
+		if err != nil {
+			t.Fatalf("RegisterCountryCode() error = %v", err)
+		}
+
+		if got := BIC("RBKOXKPR").Country(); got != xk {
+			t.Errorf("Country() got = %v, want %v", got, xk)
+		}
+	})
+}
+
+func TestBIC_Marshal(t *testing.T) {
+	type payment struct {
+		BIC BIC `json:"bic"`
+	}
+
+	b, err := json.Marshal(payment{BIC: "DEUTDEFF"})
+	if err != nil || string(b) != `{"bic":"DEUTDEFF"}` {
+		t.Errorf("Marshal() got = %s, %v", b, err)
+	}
+
+	if b, err := json.Marshal(payment{}); err != nil || string(b) != `{"bic":null}` {
+		t.Errorf("Marshal() got = %s, %v, want null", b, err)
+	}
+
+	var got payment
+	if err := json.Unmarshal([]byte(`{"bic":"deutdeff500"}`), &got); err != nil || got.BIC != "DEUTDEFF500" {
+		t.Errorf("Unmarshal() got = %v, %v", got.BIC, err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"bic":"DEUT"}`), &got); !errors.Is(err, ErrInvalidBIC) {
+		t.Errorf("Unmarshal() error = %v, wantErr %v", err, ErrInvalidBIC)
+	}
+
+	if _, err := BIC("DEUT").MarshalText(); !errors.Is(err, ErrMarshalText) {
+		t.Errorf("MarshalText() error = %v, wantErr %v", err, ErrMarshalText)
+	}
+
+	var scanned BIC
+	if err := scanned.Scan("NWBKGB2L"); err != nil || scanned != "NWBKGB2L" {
+		t.Errorf("Scan() got = %v, %v", scanned, err)
+	}
+
+	if v, err := BIC("").Value(); err != nil || v != nil {
+		t.Errorf("Value() got = %v, %v, want nil", v, err)
+	}
+}
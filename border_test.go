@@ -0,0 +1,166 @@
+package isocodes
+
+import (
+	"math"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestCountryCode_Neighbors(t *testing.T) {
+	type tcase struct {
+		code CountryCode
+		want []CountryCode
+	}
+
+	tests := map[string]tcase{
+		"DE":   {DE, []CountryCode{AT, BE, CH, CZ, DK, FR, LU, NL, PL}},
+		"PT":   {PT, []CountryCode{ES}},
+		"GF":   {GF, []CountryCode{BR, SR}},
+		"VA":   {VA, []CountryCode{IT}},
+		"GB":   {GB, []CountryCode{IE}},
+		"JP":   {JP, nil},
+		"Zero": {UnknownCountry, nil},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := tc.code.Neighbors(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("Neighbors() got = %v, want %v", got, tc.want)
+			}
+
+			for _, n := range tc.want {
+				if !tc.code.IsNeighbor(n) {
+					t.Errorf("IsNeighbor(%v) got = false, want true", n)
+				}
+			}
+		})
+	}
+
+	t.Run("Symmetric", func(t *testing.T) {
+		for c, neighbors := range countryBorders {
+			if !sort.SliceIsSorted(neighbors, func(i, j int) bool { return neighbors[i].String() < neighbors[j].String() }) {
+				t.Errorf("neighbors of %v are not sorted: %v", c, neighbors)
+			}
+
+			for _, n := range neighbors {
+				if n == c || !n.IsNeighbor(c) {
+					t.Errorf("%v lists %v as a neighbor, but not vice versa", c, n)
+				}
+			}
+		}
+	})
+
+	// Neighbors are validated against embedded boundaries, which touch
+	// each other within a few kilometers despite the simplification.
+	// Natural Earth 1:110m has no Gaza Strip in PS and NG meets TD
+	// in Lake Chad, which is cut out of their boundaries.
+	t.Run("Boundaries", func(t *testing.T) {
+		exceptions := map[[2]CountryCode]bool{{EG, PS}: true, {NG, TD}: true}
+		maxGap := 30 + BoundariesTolerance*EarthRadius*math.Pi/180
+
+		polygons := make(map[CountryCode][]boundary)
+		for _, b := range countryBoundaries {
+			polygons[b.country()] = append(polygons[b.country()], b)
+		}
+
+		for c, neighbors := range countryBorders {
+			for _, n := range neighbors {
+				if c.String() > n.String() || polygons[c] == nil || polygons[n] == nil || exceptions[[2]CountryCode{c, n}] {
+					continue
+				}
+
+				d := math.Min(boundariesDistance(polygons[c], polygons[n]), boundariesDistance(polygons[n], polygons[c]))
+				if d > maxGap {
+					t.Errorf("boundaries of neighbors %v and %v are %.0f km apart", c, n, d)
+				}
+			}
+		}
+	})
+}
+
+// boundariesDistance returns the minimum distance from vertices of a to edges of b.
+func boundariesDistance(a, b []boundary) float64 {
+	minimum := EarthRadius
+
+	for _, pa := range a {
+		for _, r := range pa.rings {
+			for i := 0; i < len(r); i += 2 {
+				p := Coordinates{Lat: float64(r[i+1]) / 100, Lon: float64(r[i]) / 100}
+
+				for _, pb := range b {
+					if d := pb.distanceTo(p); d < minimum {
+						minimum = d
+					}
+				}
+			}
+		}
+	}
+
+	return minimum
+}
+
+func TestBorderPath(t *testing.T) {
+	type tcase struct {
+		from, to CountryCode
+		want     []CountryCode
+	}
+
+	tests := map[string]tcase{
+		"Same":        {DE, DE, []CountryCode{DE}},
+		"Neighbors":   {DE, FR, []CountryCode{DE, FR}},
+		"PortugalDE":  {PT, DE, []CountryCode{PT, ES, FR, DE}},
+		"Alphabetic":  {DE, IT, []CountryCode{DE, AT, IT}},
+		"Continental": {PT, CN, []CountryCode{PT, ES, FR, DE, PL, RU, CN}},
+		"Island":      {GB, FR, nil},
+		"NoBorders":   {JP, KR, nil},
+		"Zero":        {UnknownCountry, DE, nil},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := BorderPath(tc.from, tc.to)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("BorderPath() got = %v, want %v", got, tc.want)
+			}
+
+			hops, ok := BorderHops(tc.from, tc.to)
+			if ok != (tc.want != nil) || (ok && hops != len(tc.want)-1) {
+				t.Errorf("BorderHops() got = %v, %v, want %v", hops, ok, len(tc.want)-1)
+			}
+		})
+	}
+}
+
+func TestCountryCode_WithinBorders(t *testing.T) {
+	type tcase struct {
+		code CountryCode
+		n    int
+		want []CountryCode
+	}
+
+	tests := map[string]tcase{
+		"Zero":      {PT, 0, nil},
+		"One":       {PT, 1, []CountryCode{ES}},
+		"Two":       {PT, 2, []CountryCode{AD, ES, FR, GI, MA}},
+		"Enclave":   {LS, 2, []CountryCode{BW, MZ, NA, SZ, ZA, ZW}},
+		"Island":    {JP, 3, nil},
+		"Exhausted": {GB, 10, []CountryCode{IE}},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := tc.code.WithinBorders(tc.n); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("WithinBorders() got = %v, want %v", got, tc.want)
+			}
+		})
+	}
+
+	t.Run("OneIsNeighbors", func(t *testing.T) {
+		for c := range countryBorders {
+			if got := c.WithinBorders(1); !reflect.DeepEqual(got, c.Neighbors()) {
+				t.Errorf("WithinBorders(1) of %v got = %v, want %v", c, got, c.Neighbors())
+			}
+		}
+	})
+}
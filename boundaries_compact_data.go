@@ -0,0 +1,300 @@
+// Code generated by gen_boundaries.go from data/ne_110m_admin_0_countries.geojson; DO NOT EDIT.
+
+//go:build isocodes_compact
+
+package isocodes
+
+// BoundariesTolerance holds the simplification tolerance of the embedded
+// country boundaries in degrees. Zero means Natural Earth 1:110m boundaries as is.
+const BoundariesTolerance = 0.25
+
+// countryBoundaries holds polygons of countries keyed by Alpha-2 code.
+// Each ring is a flat list of longitude and latitude pairs in hundredths of a degree.
+var countryBoundaries = []boundary{
+	{"AE", [][]int32{{5158, 2425, 5401, 2412, 5607, 2606, 5626, 2571, 5640, 2492, 5589, 2492, 5598, 2413, 5553, 2393, 5501, 2250, 5200, 2300, 5158, 2425}}},
+	{"AF", [][]int32{{6121, 3565, 6298, 3540, 6319, 3586, 6455, 3631, 6475, 3711, 6575, 3766, 6814, 3702, 6920, 3715, 6952, 3761, 7012, 3759, 7081, 3849, 7135, 3826, 7145, 3707, 7184, 3674, 7326, 3750, 7516, 3713, 7185, 3651, 7126, 3607, 7161, 3515, 7088, 3399, 6993, 3402, 7032, 3336, 6926, 3250, 6932, 3190, 6694, 3130, 6638, 3074, 6635, 2989, 6415, 2934, 6255, 2932, 6087, 2983, 6178, 3074, 6170, 3138, 6094, 3155, 6054, 3298, 6096, 3353, 6053, 3368, 6121, 3565}}},
+	{"AL", [][]int32{{2059, 4186, 2100, 4058, 2015, 3962, 1941, 4025, 1930, 4220, 1974, 4269, 2059, 4186}}},
+	{"AM", [][]int32{{4358, 4109, 4497, 4125, 4556, 4081, 4536, 4056, 4589, 4022, 4561, 3990, 4648, 3946, 4651, 3877, 4614, 3874, 4574, 3947, 4366, 4025, 4358, 4109}}},
+	{"AO", [][]int32{{1633, -588, 1747, -807, 1902, -799, 1942, -716, 2009, -694, 2060, -694, 2051, -730, 2173, -729, 2216, -1108, 2391, -1093, 2402, -1291, 2193, -1290, 2189, -1608, 2322, -1752, 2138, -1793, 1896, -1779, 1826, -1731, 1406, -1742, 1346, -1697, 1173, -1730, 1218, -1445, 1374, -1130, 1288, -917, 1324, -856, 1223, -629, 1338, -586, 1633, -588}}},
+	{"AO", [][]int32{{1244, -568, 1218, -579, 1191, -504, 1262, -444, 1300, -478, 1244, -568}}},
+	{"AQ", [][]int32{{-5957, -8004, -6016, -8100, -6449, -8092, -6629, -8026, -6188, -8039, -6061, -7963, -5957, -8004}}},
+	{"AQ", [][]int32{{-15921, -7950, -16113, -7963, -16371, -7860, -16311, -7822, -16125, -7838, -15948, -7905, -15921, -7950}}},
+	{"AQ", [][]int32{{-4515, -7805, -4392, -7848, -4333, -8003, -5048, -8103, -5416, -8063, -5399, -8022, -5099, -7961, -4866, -7805, -4515, -7805}}},
+	{"AQ", [][]int32{{-12121, -7350, -11872, -7348, -12023, -7409, -12262, -7366, -12241, -7332, -12121, -7350}}},
+	{"AQ", [][]int32{{-12556, -7348, -12403, -7387, -12728, -7346, -12556, -7348}}},
+	{"AQ", [][]int32{{-9898, -7193, -9679, -7195, -9620, -7252, -10078, -7250, -10233, -7189, -9898, -7193}}},
+	{"AQ", [][]int32{{-6845, -7096, -6878, -7217, -7108, -7250, -7239, -7248, -7190, -7209, -7419, -7237, -7495, -7207, -7501, -7166, -7207, -7119, -7174, -6951, -7025, -6888, -6845, -7096}}},
+	{"AQ", [][]int32{{-5861, -6415, -6202, -6480, -6265, -6548, -6212, -6619, -6375, -6650, -6567, -6795, -6320, -6923, -6181, -7072, -6069, -7317, -6083, -7370, -6435, -7526, -7060, -7663, -7724, -7671, -7693, -7710, -7366, -7791, -7793, -7838, -7802, -7918, -7536, -8026, -5969, -8238, -5822, -8322, -4976, -8173, -4281, -8208, -4077, -8136, -2855, -8034, -2969, -7963, -2969, -7926, -3564, -7946, -3578, -7834, -2888, -7667, -2246, -7611, -1752, -7513, -1570, -7450, -1541, -7411, -1647, -7387, -1545, -7315, -1229, -7240, -1030, -7127, -742, -7170, -687, -7093, -434, -7146, -66, -7123, -23, -7164, 774, -6989, 953, -7001, 1082, -7083, 1342, -6997, 1473, -7003, 1513, -7040, 1595, -7003, 1926, -6989, 2145, -7007, 2257, -7070, 2709, -7046, 3199, -6966, 3387, -6850, 3865, -6978, 4196, -6860, 4650, -6760, 4744, -6772, 5075, -6688, 5179, -6625, 5453, -6582, 5636, -6597, 5874, -6729, 6143, -6795, 6239, -6801, 6405, -6741, 6889, -6793, 6967, -6923, 6956, -6968, 6781, -7031, 6795, -7070, 6907, -7068, 6795, -7185, 6987, -7226, 7102, -7209, 7386, -6987, 7764, -6946, 7911, -6833, 8278, -6721, 8675, -6715, 8799, -6621, 8967, -6715, 9578, -6739, 9972, -6725, 10283, -6556, 10618, -6693, 11024, -6670, 11360, -6588, 11560, -6670, 11983, -6727, 12322, -6648, 12880, -6676, 13476, -6621, 13507, -6531, 13662, -6678, 13746, -6695, 14549, -6692, 14665, -6790, 14884, -6839, 15250, -6887, 15428, -6856, 15681, -6938, 15918, -6960, 16157, -7058, 16731, -7083, 17121, -7170, 16929, -7366, 16609, -7438, 16357, -7624, 16349, -7707, 16474, -7818, 16660, -7832, 16700, -7875, 16177, -7916, 15979, -8095, 16371, -8240, 16890, -8334, 16940, -8383, 17322, -8441, 17599, -8416, 18000, -8471, 18000, -9000, -18000, -9000, -18000, -8471, -17906, -8414, -17726, -8445, -17583, -8412, -17438, -8453, -17289, -8406, -16995, -8388, -16702, -8457, -15807, -8537, -15519, -8510, -14853, -8561, -14311, -8504, -14289, -8457, -15006, -8430, -15359, -8369, -15267, -8245, -15286, -8204, -15684, -8110, -15210, -8100, -15065, -8134, -14642, -8034, -14677, -7993, -14953, -7936, -15533, -7906, -15805, -7803, -15837, -7689, -15697, -7730, -15374, -7707, -15292, -7750, -15133, -7740, -14610, -7648, -14650, -7573, -14620, -7538, -14491, -7520, -14432, -7554, -13521, -7430, -12107, -7452, -11747, -7403, -11622, -7424, -11394, -7371, -11230, -7471, -11126, -7442, -10756, -7518, -10488, -7495, -10065, -7530, -10012, -7487, -10125, -7419, -10255, -7411, -10368, -7262, -9914, -7291, -9769, -7356, -9634, -7362, -9244, -7317, -9009, -7332, -8923, -7256, -8842, -7301, -8147, -7385, -8030, -7313, -7622, -7397, -7489, -7387, -6737, -7248, -6725, -7164, -6854, -6972, -6743, -6815, -6774, -6733, -6300, -6464, -5859, -6339, -5722, -6353, -5861, -6415}}},
+	{"AR", [][]int32{{-6550, -5520, -6863, -5487, -6863, -5264, -6775, -5385, -6505, -5470, -6550, -5520}}},
+	{"AR", [][]int32{{-6496, -2208, -6438, -2280, -6399, -2199, -6285, -2203, -6085, -2388, -5778, -2516, -5763, -2560, -5862, -2712, -5570, -2739, -5479, -2662, -5463, -2574, -5413, -2555, -5363, -2612, -5365, -2692, -5763, -3022, -5850, -3443, -5723, -3529, -5736, -3598, -5674, -3641, -5679, -3690, -5775, -3818, -5923, -3872, -6234, -3883, -6215, -4068, -6275, -4103, -6377, -4117, -6473, -4080, -6512, -4106, -6498, -4206, -6430, -4236, -6376, -4204, -6346, -4256, -6518, -4350, -6557, -4504, -6651, -4504, -6729, -4555, -6758, -4630, -6564, -4724, -6599, -4813, -6717, -4870, -6782, -4987, -6914, -5073, -6882, -5177, -6815, -5235, -7191, -5201, -7231, -5068, -7333, -5038, -7342, -4932, -7233, -4824, -7166, -4497, -7122, -4478, -7179, -4421, -7146, -4379, -7192, -4341, -7215, -4225, -7175, -4205, -7192, -4083, -7141, -3892, -7081, -3855, -7112, -3666, -7036, -3601, -7039, -3517, -6982, -3419, -7054, -3137, -6992, -3034, -6966, -2846, -6830, -2690, -6859, -2651, -6842, -2452, -6733, -2403, -6711, -2274, -6627, -2183, -6496, -2208}}},
+	{"AT", [][]int32{{1698, 4812, 1690, 4771, 1634, 4771, 1653, 4750, 1601, 4668, 1463, 4643, 1238, 4677, 1215, 4712, 1105, 4675, 948, 4710, 990, 4758, 1040, 4730, 1214, 4770, 1293, 4747, 1288, 4829, 1360, 4888, 1434, 4856, 1525, 4904, 1650, 4879, 1696, 4860, 1698, 4812}}},
+	{"AU", [][]int32{{14540, -4079, 14636, -4114, 14829, -4088, 14836, -4206, 14791, -4321, 14756, -4294, 14687, -4363, 14605, -4355, 14472, -4116, 14474, -4070, 14540, -4079}}},
+	{"AU", [][]int32{{14356, -1376, 14392, -1455, 14456, -1417, 14537, -1498, 14639, -1896, 14885, -2039, 14968, -2234, 15073, -2240, 15090, -2346, 15286, -2527, 15314, -2607, 15309, -2726, 15357, -2811, 15289, -3164, 15033, -3567, 15000, -3743, 14830, -3781, 14632, -3904, 14488, -3842, 14503, -3790, 14361, -3881, 14064, -3802, 13999, -3740, 13957, -3614, 13812, -3561, 13845, -3513, 13821, -3438, 13772, -3508, 13683, -3526, 13789, -3364, 13781, -3290, 13637, -3409, 13599, -3489, 13521, -3448, 13524, -3395, 13409, -3285, 13427, -3262, 13133, -3150, 12615, -3222, 12422, -3296, 12366, -3389, 11989, -3398, 11802, -3506, 11663, -3503, 11503, -3420, 11505, -3362, 11571, -3326, 11569, -3161, 11334, -2612, 11378, -2655, 11344, -2562, 11423, -2630, 11339, -2438, 11415, -2176, 11423, -2252, 11465, -2183, 11671, -2070, 12086, -1968, 12224, -1820, 12231, -1725, 12301, -1641, 12343, -1727, 12386, -1707, 12350, -1660, 12382, -1611, 12426, -1633, 12438, -1557, 12569, -1423, 12707, -1382, 12836, -1487, 12962, -1497, 12941, -1442, 13062, -1254, 13258, -1211, 13256, -1160, 13182, -1127, 13236, -1113, 13530, -1225, 13649, -1186, 13695, -1235, 13596, -1332, 13550, -1500, 14022, -1771, 14088, -1737, 14127, -1639, 14170, -1504, 14169, -1241, 14252, -1067, 14352, -1283, 14356, -1376}}},
+	{"AZ", [][]int32{{4500, 3974, 4574, 3947, 4614, 3874, 4546, 3887, 4500, 3974}}},
+	{"AZ", [][]int32{{4737, 4122, 4782, 4115, 4858, 4181, 4962, 4057, 5039, 4026, 4957, 4018, 4888, 3832, 4801, 3879, 4836, 3929, 4806, 3958, 4651, 3877, 4648, 3946, 4561, 3990, 4589, 4022, 4497, 4125, 4650, 4106, 4615, 4172, 4640, 4186, 4737, 4122}}},
+	{"BA", [][]int32{{1901, 4486, 1937, 4486, 1912, 4442, 1960, 4404, 1856, 4265, 1575, 4482, 1596, 4523, 1901, 4486}}},
+	{"BD", [][]int32{{9267, 2204, 9237, 2067, 9142, 2277, 9050, 2281, 9027, 2184, 8903, 2206, 8853, 2363, 8870, 2423, 8808, 2450, 8893, 2524, 8821, 2577, 8856, 2645, 8983, 2597, 8992, 2527, 9238, 2498, 9116, 2350, 9171, 2299, 9215, 2363, 9267, 2204}}},
+	{"BE", [][]int32{{331, 5135, 497, 5148, 616, 5080, 604, 5013, 567, 4953, 429, 4991, 266, 5080, 251, 5115, 331, 5135}}},
+	{"BF", [][]int32{{-283, 964, -478, 982, -540, 1037, -522, 1171, -401, 1347, -310, 1354, -107, 1497, 37, 1493, 102, 1285, 218, 1263, 215, 1194, 90, 1100, -294, 1096, -283, 964}}},
+	{"BG", [][]int32{{2266, 4423, 2294, 4382, 2557, 4369, 2724, 4418, 2856, 4371, 2767, 4258, 2800, 4201, 2612, 4183, 2611, 4133, 2449, 4158, 2295, 4134, 2288, 4200, 2238, 4232, 2299, 4321, 2250, 4364, 2266, 4423}}},
+	{"BI", [][]int32{{2934, -450, 2902, -284, 3047, -241, 3075, -336, 2934, -450}}},
+	{"BJ", [][]int32{{269, 626, 187, 614, 166, 913, 77, 1047, 145, 1155, 285, 1224, 361, 1166, 380, 1073, 272, 851, 269, 626}}},
+	{"BN", [][]int32{{11420, 453, 11545, 545, 11535, 432, 11466, 401, 11420, 453}}},
+	{"BO", [][]int32{{-6285, -2203, -6399, -2199, -6438, -2280, -6496, -2208, -6627, -2183, -6711, -2274, -6783, -2287, -6876, -2037, -6844, -1941, -6959, -1758, -6896, -1650, -6934, -1495, -6867, -1256, -6953, -1095, -6827, -1101, -6665, -993, -6534, -976, -6540, -1157, -6432, -1246, -6050, -1378, -6016, -1626, -5824, -1630, -5828, -1727, -5750, -1817, -5785, -1997, -5817, -2018, -5912, -1936, -6179, -1963, -6269, -2225, -6285, -2203}}},
+	{"BR", [][]int32{{-5763, -3022, -5365, -2692, -5363, -2612, -5413, -2555, -5463, -2574, -5429, -2402, -5540, -2396, -5580, -2236, -5794, -2209, -5817, -2018, -5750, -1817, -5828, -1727, -5824, -1630, -6016, -1626, -6050, -1378, -6432, -1246, -6540, -1157, -6534, -976, -6665, -993, -6827, -1101, -7055, -1101, -7048, -949, -7130, -1008, -7218, -1005, -7256, -952, -7323, -946, -7302, -903, -7399, -752, -7312, -663, -7289, -527, -7079, -425, -6989, -430, -6942, -112, -7002, 54, -6925, 60, -6922, 99, -6980, 109, -6982, 171, -6787, 169, -6754, 204, -6707, 113, -6555, 79, -6337, 220, -6427, 250, -6437, 380, -6482, 406, -6309, 377, -6097, 454, -6073, 520, -6021, 524, -5954, 396, -5997, 276, -5965, 179, -5903, 132, -5734, 195, -5600, 182, -5597, 251, -5294, 212, -5132, 420, -5051, 190, -4997, 174, -4995, 105, -5070, 22, -5039, -8, -4862, -24, -4858, -124, -4782, -58, -4491, -155, -4442, -214, -4458, -269, -4342, -238, -3998, -287, -3722, -482, -3560, -515, -3473, -734, -3513, -900, -3867, -1306, -3927, -1787, -4094, -2194, -4175, -2237, -4199, -2297, -4465, -2335, -4765, -2489, -4850, -2588, -4889, -2867, -5337, -3377, -5365, -3320, -5321, -3273, -5379, -3205, -5698, -3011, -5763, -3022}}},
+	{"BS", [][]int32{{-7753, 2376, -7841, 2458, -7819, 2521, -7789, 2517, -7753, 2376}}},
+	{"BS", [][]int32{{-7782, 2658, -7891, 2642, -7898, 2679, -7785, 2684, -7782, 2658}}},
+	{"BS", [][]int32{{-7700, 2659, -7717, 2588, -7779, 2704, -7700, 2659}}},
+	{"BT", [][]int32{{9170, 2777, 9210, 2745, 9203, 2684, 8974, 2672, 8884, 2710, 9002, 2830, 9170, 2777}}},
+	{"BW", [][]int32{{2565, -1854, 2772, -2050, 2802, -2149, 2943, -2209, 2712, -2357, 2649, -2462, 2594, -2470, 2566, -2549, 2421, -2567, 2331, -2527, 2161, -2673, 2089, -2683, 2076, -2587, 1990, -2477, 1990, -2185, 2088, -2181, 2091, -1825, 2320, -1787, 2358, -1828, 2508, -1766, 2565, -1854}}},
+	{"BY", [][]int32{{2348, 5391, 2554, 5428, 2577, 5485, 2659, 5517, 2649, 5562, 2818, 5617, 3087, 5555, 3076, 5481, 3269, 5335, 3131, 5307, 3179, 5210, 3093, 5204, 3056, 5132, 2533, 5191, 2353, 5158, 2320, 5249, 2380, 5269, 2348, 5391}}},
+	{"BZ", [][]int32{{-8914, 1781, -8849, 1849, -8811, 1835, -8836, 1653, -8893, 1589, -8923, 1589, -8914, 1781}}},
+	{"CA", [][]int32{{-6366, 4655, -6201, 4644, -6287, 4597, -6439, 4673, -6401, 4704, -6366, 4655}}},
+	{"CA", [][]int32{{-6181, 4911, -6452, 4987, -6286, 4971, -6181, 4911}}},
+	{"CA", [][]int32{{-12351, 4851, -12566, 4883, -12703, 4981, -12806, 4999, -12836, 5077, -12576, 5030, -12351, 4851}}},
+	{"CA", [][]int32{{-5613, 5069, -5680, 4981, -5614, 5015, -5547, 4994, -5582, 4959, -5348, 4925, -5379, 4852, -5309, 4869, -5265, 4754, -5307, 4666, -5418, 4681, -5396, 4763, -5424, 4775, -5540, 4688, -5600, 4692, -5529, 4739, -5625, 4763, -5927, 4760, -5942, 4790, -5880, 4825, -5923, 4852, -5736, 5072, -5674, 5129, -5541, 5159, -5613, 5069}}},
+	{"CA", [][]int32{{-13318, 5417, -13175, 5412, -13205, 5298, -13118, 5218, -13305, 5341, -13318, 5417}}},
+	{"CA", [][]int32{{-7927, 6216, -7966, 6163, -8036, 6202, -7993, 6239, -7927, 6216}}},
+	{"CA", [][]int32{{-8190, 6271, -8307, 6216, -8399, 6245, -8325, 6291, -8190, 6271}}},
+	{"CA", [][]int32{{-8516, 6566, -8498, 6522, -8446, 6537, -8164, 6446, -8155, 6398, -8010, 6373, -8099, 6341, -8255, 6365, -8311, 6410, -8552, 6305, -8587, 6364, -8722, 6354, -8635, 6404, -8588, 6574, -8516, 6566}}},
+	{"CA", [][]int32{{-7587, 6715, -7699, 6710, -7724, 6759, -7681, 6815, -7511, 6801, -7522, 6744, -7587, 6715}}},
+	{"CA", [][]int32{{-9565, 6911, -9627, 6876, -9980, 6940, -9822, 7014, -9565, 6911}}},
+	{"CA", [][]int32{{-9055, 6950, -9055, 6847, -8922, 6926, -8802, 6862, -8832, 6787, -8735, 6720, -8558, 6878, -8552, 6988, -8262, 6966, -8128, 6916, -8122, 6867, -8196, 6813, -8126, 6760, -8139, 6711, -8334, 6641, -8577, 6656, -8732, 6478, -8848, 6410, -8991, 6403, -9070, 6361, -9077, 6296, -9193, 6284, -9316, 6202, -9424, 6090, -9468, 5895, -9322, 5878, -9230, 5709, -9090, 5728, -8501, 5530, -8227, 5515, -8213, 5328, -8140, 5216, -7991, 5121, -7914, 5153, -7860, 5256, -7912, 5414, -7983, 5467, -7823, 5514, -7654, 5653, -7730, 5805, -7852, 5880, -7734, 5985, -7811, 6232, -7384, 6244, -7137, 6114, -6959, 6106, -6929, 5896, -6765, 5821, -6620, 5877, -6458, 6034, -6140, 5697, -6180, 5634, -5957, 5520, -5733, 5463, -5694, 5378, -5576, 5327, -5568, 5215, -6003, 5024, -6640, 5023, -6851, 4907, -7110, 4682, -7026, 4699, -6865, 4830, -6655, 4913, -6506, 4923, -6417, 4874, -6512, 4807, -6447, 4624, -6317, 4574, -6152, 4588, -6052, 4701, -6045, 4628, -5980, 4592, -6536, 4355, -6612, 4362, -6616, 4447, -6443, 4529, -6714, 4514, -6779, 4570, -6779, 4707, -6924, 4745, -7066, 4546, -7151, 4501, -7487, 4500, -7682, 4363, -7872, 4363, -7917, 4347, -7894, 4286, -8269, 4168, -8312, 4208, -8214, 4357, -8255, 4535, -8488, 4690, -8838, 4830, -9164, 4814, -9433, 4867, -9482, 4939, -9516, 4938, -9516, 4900, -12297, 4900, -12562, 5042, -12744, 5083, -12799, 5172, -12785, 5233, -12913, 5276, -12931, 5356, -13051, 5429, -13001, 5592, -13171, 5655, -13336, 5841, -13548, 5979, -13745, 5891, -13904, 6000, -14100, 6031, -14099, 6971, -13650, 6890, -13441, 6963, -13293, 6951, -12979, 7019, -12911, 6978, -12814, 7048, -12576, 6948, -12442, 7016, -12429, 6940, -12147, 6980, -11525, 6891, -11390, 6840, -11530, 6790, -11350, 6769, -10995, 6798, -10888, 6738, -10779, 6789, -10881, 6831, -10817, 6865, -10615, 6880, -10434, 6802, -10145, 6765, -9844, 6778, -9856, 6840, -9767, 6858, -9612, 6824, -9613, 6729, -9549, 6809, -9469, 6806, -9423, 6907, -9647, 7009, -9639, 7119, -9521, 7192, -9288, 7132, -9152, 7019, -9241, 6970, -9055, 6950}}},
+	{"CA", [][]int32{{-11417, 7312, -11467, 7265, -11244, 7296, -11105, 7245, -10992, 7296, -10901, 7263, -10819, 7165, -10769, 7207, -10840, 7309, -10652, 7308, -10540, 7267, -10446, 7099, -10098, 7002, -10109, 6958, -10273, 6950, -10209, 6912, -10243, 6875, -10596, 6918, -11331, 6854, -11385, 6901, -11611, 6917, -11734, 6996, -11242, 7037, -11790, 7054, -11843, 7091, -11611, 7131, -11940, 7156, -11787, 7271, -11519, 7331, -11417, 7312}}},
+	{"CA", [][]int32{{-10450, 7342, -10538, 7276, -10694, 7346, -10450, 7342}}},
+	{"CA", [][]int32{{-7634, 7310, -7625, 7283, -7949, 7274, -8088, 7333, -8083, 7369, -8035, 7376, -7806, 7365, -7634, 7310}}},
+	{"CA", [][]int32{{-8656, 7316, -8577, 7253, -8485, 7334, -8232, 7375, -8060, 7272, -8075, 7206, -7782, 7275, -7423, 7177, -7410, 7133, -7224, 7156, -7120, 7092, -6879, 7053, -6697, 6919, -6881, 6872, -6486, 6785, -6342, 6693, -6185, 6686, -6216, 6616, -6392, 6500, -6672, 6639, -6802, 6626, -6814, 6569, -6532, 6438, -6467, 6339, -6501, 6267, -6878, 6375, -6617, 6193, -7102, 6291, -7224, 6340, -7189, 6368, -7483, 6468, -7482, 6439, -7771, 6423, -7856, 6457, -7790, 6531, -7396, 6545, -7429, 6581, -7265, 6728, -7293, 6773, -7687, 6889, -7623, 6915, -7896, 7017, -8131, 6974, -8494, 6997, -8868, 7041, -8951, 7076, -8847, 7122, -8989, 7122, -9021, 7224, -8944, 7313, -8583, 7380, -8656, 7316}}},
+	{"CA", [][]int32{{-10036, 7384, -9738, 7376, -9712, 7347, -9805, 7299, -9654, 7256, -9672, 7166, -9932, 7136, -10250, 7251, -10248, 7283, -10044, 7271, -10154, 7336, -10036, 7384}}},
+	{"CA", [][]int32{{-9320, 7277, -9427, 7202, -9541, 7206, -9602, 7344, -9450, 7413, -9051, 7386, -9200, 7297, -9320, 7277}}},
+	{"CA", [][]int32{{-12046, 7138, -12309, 7090, -12593, 7187, -12394, 7368, -12492, 7429, -11756, 7419, -11551, 7348, -11922, 7252, -12046, 7182, -12046, 7138}}},
+	{"CA", [][]int32{{-9361, 7498, -9416, 7459, -9682, 7493, -9485, 7565, -9361, 7498}}},
+	{"CA", [][]int32{{-9850, 7672, -9774, 7626, -9816, 7500, -9981, 7490, -10088, 7506, -10086, 7564, -10250, 7556, -10257, 7634, -9850, 7672}}},
+	{"CA", [][]int32{{-10821, 7620, -10782, 7585, -10588, 7597, -10570, 7548, -10631, 7501, -11222, 7442, -11374, 7439, -11387, 7472, -11179, 7516, -11771, 7522, -11540, 7648, -10907, 7547, -11050, 7643, -10958, 7679, -10855, 7668, -10821, 7620}}},
+	{"CA", [][]int32{{-9468, 7710, -9161, 7678, -9074, 7645, -9097, 7607, -8919, 7561, -8113, 7571, -7983, 7492, -8195, 7444, -8976, 7452, -9242, 7484, -9289, 7588, -9389, 7632, -9712, 7675, -9675, 7716, -9468, 7710}}},
+	{"CA", [][]int32{{-11620, 7765, -11634, 7688, -11711, 7653, -12150, 7590, -12285, 7612, -11910, 7751, -11620, 7765}}},
+	{"CA", [][]int32{{-9384, 7752, -9430, 7749, -9617, 7756, -9644, 7783, -9442, 7782, -9372, 7763, -9384, 7752}}},
+	{"CA", [][]int32{{-11019, 7770, -11205, 7741, -11353, 7773, -11272, 7805, -10985, 7800, -11019, 7770}}},
+	{"CA", [][]int32{{-10966, 7860, -11254, 7841, -11150, 7885, -10966, 7860}}},
+	{"CA", [][]int32{{-9583, 7806, -9812, 7808, -9863, 7887, -9556, 7842, -9583, 7806}}},
+	{"CA", [][]int32{{-10006, 7832, -9967, 7791, -10518, 7838, -10421, 7868, -10542, 7892, -10549, 7930, -10083, 7880, -10006, 7832}}},
+	{"CA", [][]int32{{-8702, 7966, -8581, 7934, -8904, 7829, -9080, 7822, -9288, 7834, -9395, 7875, -9394, 7911, -9315, 7938, -9497, 7937, -9671, 8016, -9532, 8091, -9430, 8098, -9474, 8121, -9241, 8126, -9113, 8072, -8781, 8032, -8702, 7966}}},
+	{"CA", [][]int32{{-6850, 8311, -6185, 8263, -6189, 8236, -6766, 8150, -6548, 8151, -6947, 8062, -7118, 7980, -7691, 7932, -7553, 7920, -7622, 7902, -7539, 7853, -7976, 7721, -7789, 7678, -8056, 7618, -8949, 7647, -8962, 7695, -8777, 7718, -8826, 7790, -8498, 7754, -8634, 7818, -8796, 7837, -8509, 7935, -8651, 7974, -8693, 8025, -8341, 8010, -8185, 8046, -8760, 8052, -8937, 8086, -9159, 8189, -8550, 8265, -8318, 8232, -8242, 8286, -7931, 8313, -6850, 8311}}},
+	{"CD", [][]int32{{3083, 351, 3077, 234, 3117, 220, 2988, 60, 2902, -284, 2942, -594, 3074, -834, 2900, -841, 2845, -916, 2837, -1179, 2934, -1236, 2962, -1218, 2970, -1326, 2893, -1325, 2716, -1161, 2655, -1192, 2431, -1126, 2426, -1095, 2216, -1108, 2173, -729, 2051, -730, 2060, -694, 2009, -694, 1942, -716, 1902, -799, 1747, -807, 1633, -588, 1232, -610, 1263, -499, 1360, -450, 1458, -497, 1601, -354, 1641, -174, 1764, -42, 1854, 420, 1947, 503, 2241, 403, 2284, 471, 2441, 511, 2737, 523, 2798, 441, 2972, 460, 3083, 351}}},
+	{"CF", [][]int32{{1528, 742, 1796, 789, 1891, 863, 1881, 898, 2100, 948, 2172, 1057, 2286, 1114, 2355, 1009, 2346, 895, 2511, 783, 2737, 523, 2441, 511, 2284, 471, 2241, 403, 1947, 503, 1854, 420, 1845, 350, 1713, 373, 1601, 227, 1586, 301, 1448, 473, 1454, 623, 1528, 742}}},
+	{"CG", [][]int32{{1300, -478, 1262, -444, 1191, -504, 1109, -398, 1186, -343, 1148, -277, 1250, -239, 1258, -195, 1311, -243, 1399, -247, 1430, -200, 1432, -55, 1384, 4, 1428, 120, 1328, 131, 1308, 227, 1594, 173, 1713, 373, 1845, 350, 1764, -42, 1641, -174, 1601, -354, 1458, -497, 1414, -451, 1300, -478}}},
+	{"CH", [][]int32{{959, 4753, 948, 4710, 1044, 4689, 1036, 4648, 727, 4578, 650, 4643, 602, 4627, 674, 4754, 852, 4783, 959, 4753}}},
+	{"CI", [][]int32{{-286, 499, -465, 517, -771, 436, -757, 571, -860, 647, -830, 832, -783, 858, -823, 1013, -685, 1014, -621, 1052, -605, 1010, -540, 1037, -433, 961, -351, 990, -283, 964, -256, 822, -324, 625, -286, 499}}},
+	{"CL", [][]int32{{-6863, -5264, -6863, -5487, -6696, -5490, -6815, -5561, -7101, -5505, -7466, -5284, -7111, -5407, -7027, -5293, -6863, -5264}}},
+	{"CL", [][]int32{{-6822, -2149, -6783, -2287, -6699, -2299, -6733, -2403, -6842, -2452, -6859, -2651, -6830, -2690, -6966, -2846, -6992, -3034, -7054, -3137, -6982, -3419, -7039, -3517, -7036, -3601, -7112, -3666, -7081, -3855, -7141, -3892, -7192, -4083, -7175, -4205, -7215, -4225, -7192, -4341, -7146, -4379, -7179, -4421, -7122, -4478, -7166, -4497, -7233, -4824, -7342, -4932, -7333, -5038, -7231, -5068, -7191, -5201, -6857, -5230, -7085, -5290, -7101, -5383, -7143, -5386, -7495, -5226, -7561, -4867, -7518, -4771, -7413, -4694, -7564, -4665, -7469, -4576, -7435, -4410, -7324, -4445, -7272, -4238, -7339, -4212, -7370, -4337, -7433, -4322, -7368, -3994, -7322, -3926, -7359, -3716, -7317, -3712, -7144, -3242, -7149, -2886, -7091, -2764, -7009, -2139, -7037, -1835, -6959, -1758, -6844, -1941, -6876, -2037, -6822, -2149}}},
+	{"CM", [][]int32{{1308, 227, 965, 228, 980, 307, 850, 477, 923, 644, 1012, 704, 1106, 664, 1175, 698, 1357, 1080, 1442, 1157, 1458, 1209, 1418, 1248, 1450, 1286, 1547, 998, 1417, 1002, 1395, 955, 1498, 880, 1544, 769, 1454, 623, 1448, 473, 1586, 301, 1594, 173, 1308, 227}}},
+	{"CN", [][]int32{{11034, 1868, 10948, 1820, 10866, 1851, 10863, 1937, 10912, 1982, 11079, 2008, 11101, 1970, 11034, 1868}}},
+	{"CN", [][]int32{{12766, 4976, 12940, 4944, 13058, 4873, 13099, 4779, 13251, 4779, 13503, 4848, 13310, 4514, 13188, 4532, 13103, 4497, 13114, 4293, 13063, 4290, 13064, 4240, 12999, 4299, 12960, 4242, 12805, 4199, 12821, 4147, 12687, 4182, 12427, 3993, 12105, 3890, 12217, 4042, 12164, 4095, 11902, 3925, 11804, 3920, 11753, 3874, 11970, 3716, 12082, 3787, 12236, 3745, 12252, 3693, 12110, 3665, 11915, 3491, 12023, 3436, 12191, 3169, 12189, 3095, 12126, 3068, 12209, 2983, 12168, 2823, 12113, 2814, 11866, 2455, 11589, 2278, 11415, 2222, 11381, 2255, 11324, 2205, 11079, 2140, 11044, 2034, 10989, 2028, 10986, 2140, 10704, 2181, 10657, 2222, 10673, 2279, 10533, 2335, 10448, 2282, 10165, 2232, 10180, 2117, 10127, 2120, 10115, 2185, 10042, 2156, 9924, 2212, 9953, 2295, 9890, 2314, 9866, 2406, 9760, 2390, 9772, 2508, 9867, 2592, 9868, 2751, 9791, 2834, 9625, 2841, 9659, 2883, 9612, 2945, 9540, 2903, 9457, 2928, 9250, 2790, 9170, 2777, 9002, 2830, 8881, 2730, 8873, 2809, 8582, 2820, 8233, 3012, 8153, 3042, 8111, 3018, 7972, 3088, 7874, 3152, 7846, 3262, 7918, 3248, 7891, 3432, 7784, 3549, 7619, 3590, 7498, 3742, 7486, 3838, 7393, 3851, 7368, 3943, 7382, 3989, 7478, 4037, 7653, 4043, 7690, 4107, 7819, 4119, 8012, 4212, 8018, 4292, 8087, 4318, 7997, 4492, 8246, 4554, 8318, 4733, 8516, 4700, 8572, 4745, 8577, 4846, 8775, 4930, 8801, 4860, 9028, 4769, 9097, 4689, 9059, 4572, 9095, 4529, 9348, 4498, 9531, 4424, 9635, 4273, 10085, 4266, 10496, 4160, 10613, 4213, 11041, 4287, 11183, 4374, 11135, 4446, 11187, 4510, 11346, 4481, 11742, 4667, 11966, 4669, 11977, 4705, 11806, 4807, 11730, 4770, 11574, 4773, 11549, 4814, 11668, 4989, 11788, 4951, 11929, 5014, 11928, 5058, 12074, 5196, 12073, 5252, 12018, 5275, 12100, 5325, 12357, 5346, 12595, 5279, 12766, 4976}}},
+	{"CO", [][]int32{{-7537, -15, -7629, 42, -7742, 40, -7899, 169, -7713, 385, -7750, 409, -7732, 585, -7775, 771, -7724, 794, -7735, 867, -7567, 944, -7548, 1062, -7491, 1108, -7341, 1123, -7140, 1238, -7133, 1178, -7197, 1161, -7291, 1045, -7330, 915, -7279, 909, -7244, 742, -7196, 699, -7009, 696, -6939, 610, -6734, 610, -6782, 450, -6730, 332, -6781, 282, -6688, 125, -6754, 204, -6787, 169, -6982, 171, -6980, 109, -6922, 99, -6925, 60, -7002, 54, -6942, -112, -6989, -430, -7069, -374, -7005, -273, -7081, -226, -7307, -231, -7366, -126, -7537, -15}}},
+	{"CR", [][]int32{{-8297, 823, -8498, 1009, -8511, 956, -8566, 993, -8594, 1090, -8556, 1122, -8366, 1094, -8255, 957, -8293, 948, -8297, 823}}},
+	{"CU", [][]int32{{-8227, 2319, -8062, 2311, -7928, 2240, -7835, 2251, -7652, 2121, -7560, 2102, -7567, 2074, -7418, 2028, -7496, 1992, -7776, 1986, -7709, 2041, -7814, 2074, -7872, 2160, -8217, 2239, -8180, 2264, -8278, 2269, -8405, 2191, -8497, 2190, -8378, 2279, -8227, 2319}}},
+	{"CY", [][]int32{{3273, 3514, 3458, 3567, 3397, 3506, 3273, 3514}}},
+	{"CY", [][]int32{{3397, 3506, 3298, 3457, 3226, 3510, 3397, 3506}}},
+	{"CZ", [][]int32{{1696, 4860, 1525, 4904, 1434, 4856, 1252, 4955, 1224, 5027, 1502, 5111, 1672, 5022, 1755, 5036, 1885, 4950, 1696, 4860}}},
+	{"DE", [][]int32{{992, 5498, 994, 5460, 1095, 5436, 1094, 5401, 1252, 5447, 1365, 5408, 1435, 5325, 1407, 5298, 1502, 5111, 1224, 5027, 1252, 4955, 1360, 4888, 1288, 4829, 1293, 4747, 747, 4762, 810, 4902, 619, 4946, 599, 5185, 684, 5223, 691, 5348, 812, 5353, 880, 5402, 853, 5496, 992, 5498}}},
+	{"DJ", [][]int32{{4308, 1270, 4329, 1197, 4272, 1174, 4315, 1146, 4278, 1093, 4176, 1105, 4166, 1163, 4235, 1254, 4308, 1270}}},
+	{"DK", [][]int32{{1269, 5561, 1209, 5480, 1090, 5578, 1237, 5611, 1269, 5561}}},
+	{"DK", [][]int32{{1091, 5646, 965, 5547, 992, 5498, 853, 5496, 812, 5552, 809, 5654, 854, 5711, 1058, 5773, 1025, 5689, 1091, 5646}}},
+	{"DO", [][]int32{{-7171, 1971, -6995, 1965, -6832, 1861, -6869, 1821, -7067, 1843, -7140, 1760, -7195, 1862, -7171, 1971}}},
+	{"DZ", [][]int32{{1200, 2347, 568, 1960, 316, 1906, 315, 1969, -868, 2740, -867, 2884, -524, 3000, -369, 3090, -365, 3164, -131, 3226, -112, 3265, -217, 3517, -121, 3571, 147, 3661, 532, 3672, 626, 3711, 842, 3695, 814, 3466, 752, 3410, 761, 3334, 906, 3210, 981, 2942, 972, 2651, 932, 2609, 1030, 2438, 1077, 2456, 1200, 2347}}},
+	{"EC", [][]int32{{-8030, -340, -7977, -266, -7999, -222, -8037, -269, -8097, -225, -8093, -106, -8058, -91, -8009, 77, -7886, 138, -7742, 40, -7629, 42, -7537, -15, -7554, -156, -7664, -261, -7784, -300, -7921, -496, -7962, -445, -8044, -443, -8030, -340}}},
+	{"EE", [][]int32{{2431, 5779, 2443, 5838, 2343, 5861, 2334, 5919, 2586, 5961, 2813, 5930, 2742, 5872, 2772, 5779, 2729, 5747, 2516, 5797, 2431, 5779}}},
+	{"EG", [][]int32{{3492, 2950, 3392, 2765, 3232, 2976, 3410, 2614, 3569, 2393, 3553, 2310, 3687, 2200, 2500, 2200, 2500, 2924, 2470, 3004, 2516, 3157, 2650, 3159, 2891, 3087, 3098, 3156, 3169, 3143, 3196, 3093, 3219, 3126, 3377, 3097, 3427, 3122, 3492, 2950}}},
+	{"EH", [][]int32{{-879, 2712, -867, 2766, -869, 2588, -1197, 2593, -1194, 2337, -1287, 2328, -1293, 2133, -1685, 2133, -1706, 2100, -1702, 2142, -1475, 2150, -1389, 2369, -1250, 2477, -1139, 2688, -879, 2712}}},
+	{"ER", [][]int32{{4235, 1254, 4090, 1412, 4003, 1452, 3851, 1451, 3791, 1496, 3759, 1421, 3643, 1442, 3685, 1696, 3841, 1800, 3927, 1592, 4308, 1270, 4235, 1254}}},
+	{"ES", [][]int32{{-903, 4188, -898, 4259, -939, 4303, -798, 4375, -190, 4342, 34, 4258, 299, 4247, 304, 4189, 209, 4123, 81, 4101, -28, 3931, 11, 3874, -68, 3764, -144, 3744, -215, 3667, -437, 3668, -538, 3595, -754, 3743, -703, 3808, -750, 3963, -707, 3971, -685, 4111, -639, 4138, -667, 4188, -801, 4179, -826, 4228, -903, 4188}}},
+	{"ET", [][]int32{{3791, 1496, 3851, 1451, 4003, 1452, 4160, 1345, 4235, 1254, 4166, 1163, 4176, 1105, 4278, 1093, 4256, 1057, 4368, 918, 4779, 800, 4496, 500, 4366, 496, 4186, 392, 4077, 426, 3956, 342, 3812, 360, 3686, 445, 3616, 445, 3471, 659, 3357, 771, 3295, 778, 3329, 835, 3383, 838, 3426, 1063, 3586, 1258, 3643, 1442, 3759, 1421, 3791, 1496}}},
+	{"FI", [][]int32{{2859, 6906, 2845, 6836, 2998, 6770, 2905, 6694, 3022, 6581, 2954, 6495, 3044, 6420, 3004, 6355, 3152, 6287, 3114, 6236, 2807, 6050, 2287, 5985, 2132, 6072, 2154, 6171, 2106, 6261, 2154, 6319, 2540, 6511, 2529, 6553, 2357, 6640, 2354, 6794, 2065, 6911, 2124, 6937, 2236, 6884, 2474, 6865, 2569, 6909, 2618, 6983, 2773, 7016, 2902, 6977, 2859, 6906}}},
+	{"FJ", [][]int32{{17837, -1734, 17872, -1763, 17855, -1815, 17738, -1816, 17767, -1738, 17837, -1734}}},
+	{"FJ", [][]int32{{17936, -1680, 17873, -1701, 17860, -1664, 18000, -1607, 18000, -1656, 17936, -1680}}},
+	{"FJ", [][]int32{{-17992, -1650, -18000, -1656, -18000, -1607, -17979, -1602, -17992, -1650}}},
+	{"FK", [][]int32{{-6120, -5185, -6000, -5125, -5915, -5150, -5855, -5110, -5775, -5155, -5940, -5220, -5985, -5185, -6070, -5230, -6120, -5185}}},
+	{"FR", [][]int32{{956, 4215, 923, 4138, 854, 4226, 939, 4301, 956, 4215}}},
+	{"FR", [][]int32{{359, 5038, 810, 4902, 747, 4762, 674, 4754, 604, 4673, 602, 4627, 650, 4643, 684, 4599, 710, 4533, 675, 4503, 701, 4425, 755, 4413, 744, 4369, 653, 4313, 456, 4340, 310, 4308, 299, 4247, 183, 4234, -150, 4303, -190, 4342, -138, 4402, -119, 4601, -296, 4757, -449, 4795, -459, 4868, -162, 4864, -193, 4978, -99, 4935, 134, 5013, 164, 5095, 251, 5115, 359, 5038}}},
+	{"GA", [][]int32{{1109, -398, 941, -214, 883, -78, 949, 101, 1129, 106, 1128, 226, 1295, 232, 1328, 131, 1428, 120, 1384, 4, 1432, -55, 1430, -200, 1399, -247, 1311, -243, 1258, -195, 1250, -239, 1148, -277, 1186, -343, 1109, -398}}},
+	{"GB", [][]int32{{-566, 5455, -620, 5387, -757, 5406, -757, 5513, -673, 5517, -566, 5455}}},
+	{"GB", [][]int32{{-301, 5864, -407, 5755, -196, 5768, -312, 5597, -209, 5591, -111, 5462, -43, 5446, 47, 5293, 168, 5274, 156, 5210, 105, 5181, 145, 5129, 55, 5077, -296, 5070, -525, 4996, -578, 5016, -341, 5143, -498, 5159, -527, 5199, -422, 5230, -477, 5284, -458, 5350, -309, 5340, -295, 5398, -363, 5462, -484, 5479, -508, 5506, -472, 5551, -505, 5578, -559, 5531, -564, 5628, -615, 5679, -501, 5863, -301, 5864}}},
+	{"GE", [][]int32{{4155, 4154, 4145, 4265, 4008, 4355, 4547, 4250, 4640, 4186, 4615, 4172, 4664, 4118, 4522, 4141, 4358, 4109, 4262, 4158, 4155, 4154}}},
+	{"GF", [][]int32{{-5256, 250, -5342, 205, -5452, 231, -5401, 362, -5448, 490, -5396, 576, -5182, 457, -5166, 416, -5256, 250}}},
+	{"GH", [][]int32{{106, 593, -196, 471, -286, 499, -324, 625, -256, 822, -294, 1096, 2, 1102, 71, 831, 57, 691, 106, 593}}},
+	{"GL", [][]int32{{-4676, 8263, -3862, 8355, -2710, 8352, -2085, 8273, -2269, 8234, -3190, 8220, -2484, 8179, -2290, 8209, -2207, 8173, -2317, 8115, -1577, 8191, -1277, 8172, -1221, 8129, -1685, 8035, -2005, 8018, -1773, 8013, -1970, 7875, -1967, 7764, -1847, 7699, -2168, 7663, -1983, 7610, -1960, 7525, -2067, 7516, -1937, 7430, -2159, 7422, -2043, 7382, -2076, 7346, -2357, 7331, -2231, 7263, -2230, 7218, -2428, 7260, -2479, 7233, -2213, 7147, -2175, 7066, -2354, 7047, -2554, 7143, -2520, 7075, -2636, 7023, -2235, 7013, -2775, 6847, -3178, 6812, -3420, 6668, -3981, 6546, -4067, 6484, -4119, 6348, -4282, 6268, -4242, 6190, -4338, 6010, -4479, 6004, -4626, 6085, -4826, 6086, -5163, 6363, -5228, 6518, -5366, 6610, -5330, 6684, -5397, 6719, -5298, 6836, -5148, 6873, -5087, 6993, -5346, 6928, -5468, 6961, -5436, 7082, -5139, 7057, -5400, 7155, -5583, 7165, -5472, 7259, -5732, 7471, -5860, 7510, -5859, 7552, -6127, 7610, -6850, 7606, -7140, 7701, -6676, 7738, -7330, 7804, -7316, 7843, -6571, 7939, -6532, 7976, -6802, 8012, -6223, 8132, -6265, 8177, -5721, 8219, -5304, 8189, -5039, 8244, -4452, 8166, -4690, 8220, -4676, 8263}}},
+	{"GM", [][]int32{{-1684, 1315, -1671, 1359, -1540, 1386, -1384, 1351, -1684, 1315}}},
+	{"GN", [][]int32{{-844, 769, -921, 731, -976, 854, -1051, 835, -1112, 1005, -1243, 984, -1325, 890, -1513, 1104, -1374, 1181, -1370, 1259, -1151, 1244, -1146, 1208, -1017, 1184, -913, 1231, -803, 1021, -831, 979, -783, 858, -844, 769}}},
+	{"GQ", [][]int32{{949, 101, 965, 228, 1128, 226, 1129, 106, 949, 101}}},
+	{"GR", [][]int32{{2370, 3571, 2629, 3530, 2616, 3500, 2472, 3492, 2351, 3528, 2370, 3571}}},
+	{"GR", [][]int32{{2660, 4156, 2606, 4082, 2371, 4069, 2441, 4012, 2390, 3996, 2281, 4048, 2285, 3966, 2335, 3919, 2297, 3897, 2403, 3822, 2404, 3766, 2312, 3792, 2341, 3741, 2277, 3731, 2315, 3642, 2167, 3684, 2015, 3962, 2102, 4084, 2449, 4158, 2611, 4133, 2612, 4183, 2660, 4156}}},
+	{"GT", [][]int32{{-9010, 1374, -9169, 1413, -9223, 1454, -9223, 1525, -9175, 1607, -9046, 1607, -9145, 1725, -9100, 1725, -9100, 1782, -8914, 1781, -8923, 1589, -8823, 1573, -9010, 1374}}},
+	{"GW", [][]int32{{-1513, 1104, -1609, 1152, -1668, 1238, -1370, 1259, -1374, 1181, -1513, 1104}}},
+	{"GY", [][]int32{{-5976, 837, -5715, 597, -5804, 406, -5654, 190, -5734, 195, -5854, 127, -5965, 179, -5997, 276, -5954, 396, -6011, 457, -5998, 501, -6073, 520, -6141, 596, -6116, 670, -6030, 704, -6055, 778, -5976, 837}}},
+	{"HN", [][]int32{{-8732, 1298, -8786, 1389, -8850, 1385, -8935, 1442, -8915, 1507, -8823, 1573, -8498, 1600, -8315, 1500, -8492, 1479, -8580, 1384, -8676, 1375, -8673, 1326, -8732, 1298}}},
+	{"HR", [][]int32{{1883, 4591, 1939, 4524, 1901, 4486, 1596, 4523, 1575, 4482, 1845, 4248, 1602, 4351, 1517, 4424, 1490, 4508, 1426, 4523, 1395, 4480, 1366, 4514, 1372, 4550, 1533, 4545, 1577, 4624, 1656, 4650, 1763, 4595, 1883, 4591}}},
+	{"HT", [][]int32{{-7319, 1992, -7171, 1971, -7171, 1804, -7392, 1803, -7446, 1834, -7437, 1866, -7233, 1867, -7278, 1948, -7342, 1964, -7319, 1992}}},
+	{"HU", [][]int32{{1620, 4685, 1634, 4771, 1690, 4771, 1698, 4812, 1786, 4776, 2080, 4862, 2209, 4842, 2271, 4788, 2210, 4767, 2102, 4632, 1846, 4576, 1620, 4685}}},
+	{"ID", [][]int32{{12072, -1024, 11897, -956, 11990, -936, 12072, -1024}}},
+	{"ID", [][]int32{{12444, -1014, 12346, -1024, 12398, -929, 12497, -889, 12509, -939, 12444, -1014}}},
+	{"ID", [][]int32{{11790, -810, 11888, -828, 11913, -871, 11674, -903, 11790, -810}}},
+	{"ID", [][]int32{{12290, -809, 12276, -865, 11992, -881, 11992, -844, 12072, -824, 12134, -854, 12290, -809}}},
+	{"ID", [][]int32{{10862, -678, 11054, -688, 11076, -647, 11261, -695, 11298, -759, 11571, -837, 11456, -875, 10828, -777, 10537, -685, 10605, -590, 10727, -595, 10862, -678}}},
+	{"ID", [][]int32{{13472, -621, 13421, -690, 13450, -545, 13472, -621}}},
+	{"ID", [][]int32{{12725, -346, 12687, -379, 12599, -318, 12700, -313, 12725, -346}}},
+	{"ID", [][]int32{{13047, -309, 13083, -386, 12999, -345, 12790, -339, 12814, -284, 13047, -309}}},
+	{"ID", [][]int32{{13414, -115, 13442, -277, 13546, -337, 13629, -231, 13744, -170, 14100, -260, 14103, -912, 14014, -830, 13761, -841, 13804, -760, 13867, -732, 13793, -539, 13366, -354, 13298, -411, 13275, -331, 13199, -282, 13378, -248, 13370, -221, 13223, -221, 13052, -94, 13238, -37, 13399, -78, 13414, -115}}},
+	{"ID", [][]int32{{12524, 142, 12444, 43, 12369, 24, 12018, 24, 12004, -52, 12094, -141, 12148, -96, 12334, -62, 12326, -108, 12282, -93, 12151, -190, 12245, -319, 12227, -353, 12317, -468, 12316, -534, 12263, -563, 12224, -528, 12272, -446, 12174, -485, 12149, -457, 12162, -419, 12090, -360, 12097, -263, 12031, -293, 12043, -553, 11937, -538, 11950, -349, 11908, -349, 11877, -280, 12004, 57, 12089, 131, 12293, 88, 12408, 92, 12507, 164, 12524, 142}}},
+	{"ID", [][]int32{{12869, 113, 12864, 26, 12812, 36, 12797, -25, 12838, -78, 12810, -90, 12740, 101, 12793, 217, 12800, 163, 12859, 154, 12869, 113}}},
+	{"ID", [][]int32{{11788, 183, 11900, 90, 11781, 78, 11752, -80, 11656, -149, 11615, -401, 11600, -366, 11486, -411, 11447, -350, 11326, -312, 11207, -348, 11170, -299, 11022, -293, 11007, -159, 10909, -46, 10907, 134, 10966, 201, 11051, 77, 11180, 90, 11286, 150, 11462, 143, 11587, 431, 11788, 414, 11731, 323, 11805, 229, 11788, 183}}},
+	{"ID", [][]int32{{10582, -585, 10471, -587, 10258, -422, 9926, 18, 9860, 182, 9538, 497, 9529, 548, 9748, 525, 10064, 210, 10166, 208, 10384, 10, 10344, -71, 10437, -108, 10489, -234, 10562, -243, 10611, -306, 10582, -585}}},
+	{"IE", [][]int32{{-620, 5387, -603, 5315, -679, 5226, -856, 5167, -998, 5182, -917, 5286, -969, 5388, -757, 5513, -757, 5406, -620, 5387}}},
+	{"IL", [][]int32{{3572, 3271, 3518, 3253, 3497, 3187, 3523, 3175, 3493, 3135, 3540, 3149, 3542, 3110, 3492, 2950, 3427, 3122, 3510, 3308, 3582, 3328, 3572, 3271}}},
+	{"IN", [][]int32{{7784, 3549, 7891, 3432, 7881, 3351, 7921, 3299, 7918, 3248, 7846, 3262, 7874, 3152, 8111, 3018, 8009, 2879, 8330, 2736, 8806, 2641, 8812, 2788, 8873, 2809, 8884, 2710, 8974, 2672, 9203, 2684, 9210, 2745, 9170, 2777, 9250, 2790, 9457, 2928, 9540, 2903, 9612, 2945, 9659, 2883, 9625, 2841, 9733, 2826, 9740, 2788, 9705, 2770, 9713, 2708, 9642, 2726, 9512, 2657, 9411, 2385, 9333, 2408, 9317, 2228, 9267, 2204, 9215, 2363, 9171, 2299, 9116, 2350, 9238, 2498, 8992, 2527, 8983, 2597, 8856, 2645, 8821, 2577, 8893, 2524, 8808, 2450, 8870, 2423, 8889, 2169, 8698, 2150, 8703, 2074, 8650, 2015, 8506, 1948, 8219, 1702, 8219, 1656, 8032, 1590, 7986, 1036, 7934, 1031, 7889, 955, 7919, 922, 7828, 893, 7754, 797, 7659, 890, 7486, 1274, 7444, 1462, 7353, 1599, 7263, 2136, 7118, 2076, 7047, 2088, 6916, 2209, 6964, 2245, 6935, 2284, 6818, 2369, 6884, 2436, 7104, 2436, 7017, 2649, 6951, 2694, 7062, 2799, 7178, 2791, 7442, 3098, 7441, 3169, 7526, 3227, 7445, 3276, 7375, 3432, 7424, 3475, 7687, 3465, 7784, 3549}}},
+	{"IQ", [][]int32{{4542, 3598, 4608, 3568, 4615, 3509, 4542, 3397, 4611, 3302, 4733, 3247, 4785, 3171, 4769, 3098, 4857, 2993, 4730, 3006, 4657, 2910, 4471, 2918, 4189, 3119, 3920, 3216, 3879, 3338, 4101, 3442, 4129, 3636, 4235, 3723, 4477, 3717, 4542, 3598}}},
+	{"IR", [][]int32{{5392, 3720, 5662, 3812, 6112, 3649, 6121, 3565, 6053, 3368, 6096, 3353, 6054, 3298, 6094, 3155, 6170, 3138, 6178, 3074, 6087, 2983, 6177, 2870, 6273, 2826, 6276, 2738, 6332, 2676, 6187, 2624, 6150, 2508, 5740, 2574, 5697, 2697, 5649, 2714, 5472, 2648, 5349, 2681, 5152, 2787, 5012, 3015, 4894, 3032, 4857, 2993, 4769, 3098, 4785, 3171, 4733, 3247, 4611, 3302, 4542, 3397, 4615, 3509, 4608, 3568, 4542, 3598, 4423, 3797, 4411, 3943, 4479, 3971, 4546, 3887, 4614, 3874, 4806, 3958, 4836, 3929, 4801, 3879, 4888, 3832, 4920, 3758, 5084, 3687, 5226, 3670, 5392, 3720}}},
+	{"IS", [][]int32{{-1451, 6646, -1474, 6581, -1361, 6513, -1491, 6436, -1866, 6350, -2276, 6396, -2178, 6440, -2396, 6489, -2218, 6508, -2223, 6538, -2433, 6561, -2365, 6626, -2213, 6641, -2058, 6573, -1906, 6628, -1780, 6599, -1617, 6653, -1451, 6646}}},
+	{"IT", [][]int32{{1552, 3823, 1510, 3662, 1243, 3761, 1257, 3813, 1552, 3823}}},
+	{"IT", [][]int32{{921, 4121, 981, 4050, 967, 3918, 881, 3891, 843, 3917, 816, 4095, 921, 4121}}},
+	{"IT", [][]int32{{1238, 4677, 1381, 4651, 1394, 4559, 1233, 4538, 1259, 4409, 1514, 4196, 1593, 4196, 1617, 4174, 1589, 4154, 1848, 4017, 1829, 3981, 1687, 4044, 1645, 3980, 1717, 3942, 1705, 3890, 1610, 3799, 1568, 3791, 1611, 3896, 1541, 4005, 1119, 4236, 1051, 4293, 1020, 4392, 889, 4437, 744, 4369, 755, 4413, 701, 4425, 684, 4599, 897, 4604, 918, 4644, 1036, 4648, 1044, 4689, 1215, 4712, 1238, 4677}}},
+	{"JM", [][]int32{{-7757, 1849, -7620, 1789, -7721, 1770, -7834, 1823, -7757, 1849}}},
+	{"JO", [][]int32{{3555, 3239, 3572, 3271, 3683, 3231, 3879, 3338, 3920, 3216, 3700, 3151, 3800, 3051, 3607, 2920, 3492, 2950, 3555, 3239}}},
+	{"JP", [][]int32{{13464, 3415, 13477, 3381, 13420, 3320, 13379, 3352, 13301, 3270, 13236, 3299, 13292, 3406, 13349, 3394, 13390, 3436, 13464, 3415}}},
+	{"JP", [][]int32{{14098, 3714, 14077, 3584, 14025, 3514, 13722, 3461, 13579, 3346, 13512, 3385, 13508, 3460, 13099, 3389, 13200, 3315, 13133, 3145, 13069, 3103, 13020, 3142, 13045, 3232, 12941, 3330, 13035, 3360, 13262, 3543, 13568, 3553, 13672, 3730, 13739, 3683, 13943, 3822, 14005, 3944, 13988, 4056, 14031, 4120, 14137, 4138, 14188, 3918, 14096, 3817, 14098, 3714}}},
+	{"JP", [][]int32{{14391, 4417, 14461, 4396, 14532, 4438, 14554, 4326, 14406, 4299, 14318, 4200, 14161, 4268, 14107, 4158, 13996, 4157, 13982, 4256, 14031, 4333, 14138, 4339, 14197, 4555, 14391, 4417}}},
+	{"KE", [][]int32{{4099, -86, 4159, -168, 4026, -257, 3920, -468, 3777, -368, 3770, -310, 3390, -95, 3389, 11, 3504, 191, 3401, 425, 3530, 551, 3582, 534, 3616, 445, 3686, 445, 3812, 360, 3956, 342, 4077, 426, 4186, 392, 4098, 278, 4099, -86}}},
+	{"KG", [][]int32{{7096, 4227, 7184, 4285, 7349, 4250, 7365, 4309, 7421, 4330, 7564, 4288, 7914, 4286, 8026, 4235, 7819, 4119, 7690, 4107, 7653, 4043, 7478, 4037, 7382, 3989, 7368, 3943, 6946, 3953, 6956, 4010, 7177, 4015, 7306, 4087, 7042, 4152, 7126, 4217, 7096, 4227}}},
+	{"KH", [][]int32{{10350, 1063, 10235, 1339, 10299, 1423, 10428, 1442, 10604, 1388, 10650, 1457, 10738, 1420, 10749, 1234, 10581, 1157, 10625, 1096, 10350, 1063}}},
+	{"KP", [][]int32{{13064, 4240, 12967, 4160, 12971, 4088, 12753, 3976, 12739, 3921, 12835, 3861, 12821, 3837, 12528, 3767, 12471, 3811, 12539, 3939, 12427, 3993, 12508, 4057, 12687, 4182, 12821, 4147, 12805, 4199, 12960, 4242, 12999, 4299, 13064, 4240}}},
+	{"KR", [][]int32{{12835, 3861, 12946, 3678, 12947, 3563, 12909, 3508, 12649, 3439, 12656, 3568, 12612, 3673, 12686, 3689, 12617, 3775, 12835, 3861}}},
+	{"KW", [][]int32{{4797, 2998, 4842, 2855, 4657, 2910, 4730, 3006, 4797, 2998}}},
+	{"KZ", [][]int32{{7096, 4227, 6826, 4066, 6799, 4114, 6671, 4117, 6651, 4199, 6602, 4199, 6610, 4300, 6490, 4373, 6201, 4350, 6106, 4441, 5850, 4559, 5593, 4500, 5597, 4131, 5546, 4126, 5408, 4232, 5250, 4178, 5250, 4279, 5134, 4313, 5031, 4461, 5128, 4451, 5132, 4525, 5304, 4526, 5304, 4685, 5119, 4705, 4910, 4640, 4859, 4656, 4869, 4708, 4806, 4774, 4732, 4772, 4647, 4839, 4755, 5045, 4858, 4987, 4870, 5061, 5077, 5169, 5233, 5172, 5572, 5062, 5678, 5104, 5836, 5106, 5964, 5055, 5993, 5084, 6134, 5080, 6159, 5127, 5997, 5196, 6093, 5245, 6074, 5272, 6170, 5298, 6098, 5366, 6144, 5401, 6518, 5435, 6907, 5539, 7087, 5517, 7118, 5413, 7222, 5438, 7351, 5404, 7343, 5349, 7689, 5449, 7653, 5418, 7780, 5340, 8004, 5086, 8057, 5139, 8195, 5081, 8338, 5107, 8554, 4969, 8683, 4983, 8736, 4921, 8660, 4855, 8577, 4846, 8572, 4745, 8516, 4700, 8318, 4733, 8246, 4554, 7997, 4492, 8087, 4318, 8018, 4292, 8026, 4235, 7914, 4286, 7564, 4288, 7421, 4330, 7365, 4309, 7349, 4250, 7184, 4285, 7096, 4227}}},
+	{"LA", [][]int32{{10522, 1427, 10559, 1557, 10396, 1824, 10211, 1811, 10106, 1751, 10128, 1946, 10061, 1951, 10012, 2042, 10118, 2144, 10180, 2117, 10165, 2232, 10217, 2246, 10320, 2077, 10444, 2076, 10482, 1989, 10390, 1927, 10509, 1867, 10731, 1591, 10738, 1420, 10650, 1457, 10604, 1388, 10522, 1427}}},
+	{"LB", [][]int32{{3582, 3328, 3513, 3309, 3600, 3464, 3645, 3459, 3661, 3420, 3582, 3328}}},
+	{"LK", [][]int32{{8179, 752, 8164, 648, 8035, 597, 7970, 820, 8015, 982, 8179, 752}}},
+	{"LR", [][]int32{{-771, 436, -900, 483, -1144, 679, -1023, 841, -976, 854, -921, 731, -844, 769, -860, 647, -757, 571, -771, 436}}},
+	{"LS", [][]int32{{2898, -2896, 2933, -2926, 2811, -3055, 2775, -3065, 2700, -2988, 2807, -2885, 2854, -2865, 2898, -2896}}},
+	{"LT", [][]int32{{2273, 5433, 2276, 5486, 2127, 5519, 2106, 5603, 2486, 5637, 2649, 5562, 2659, 5517, 2577, 5485, 2554, 5428, 2445, 5391, 2273, 5433}}},
+	{"LU", [][]int32{{604, 5013, 619, 4946, 567, 4953, 604, 5013}}},
+	{"LV", [][]int32{{2106, 5603, 2158, 5741, 2252, 5775, 2332, 5701, 2412, 5703, 2431, 5779, 2516, 5797, 2777, 5724, 2818, 5617, 2649, 5562, 2486, 5637, 2106, 5603}}},
+	{"LY", [][]int32{{1485, 2286, 1414, 2249, 1358, 2304, 1200, 2347, 1077, 2456, 1030, 2438, 932, 2609, 972, 2651, 986, 2896, 948, 3031, 997, 3054, 995, 3138, 1143, 3237, 1149, 3314, 1525, 3227, 1571, 3138, 1909, 3027, 2005, 3099, 1982, 3175, 2085, 3271, 2290, 3264, 2324, 3219, 2492, 3190, 2500, 2000, 2385, 2000, 2384, 1958, 1586, 2341, 1485, 2286}}},
+	{"MA", [][]int32{{-519, 3576, -459, 3533, -217, 3517, -112, 3265, -131, 3226, -365, 3164, -369, 3090, -524, 3000, -867, 2884, -879, 2712, -1139, 2688, -1250, 2477, -1389, 2369, -1475, 2150, -1702, 2142, -1444, 2625, -1262, 2804, -1169, 2815, -956, 2993, -981, 3118, -930, 3256, -691, 3411, -593, 3576, -519, 3576}}},
+	{"MD", [][]int32{{2662, 4822, 2752, 4847, 2867, 4812, 3002, 4642, 2886, 4644, 2823, 4549, 2813, 4681, 2662, 4822}}},
+	{"ME", [][]int32{{1980, 4250, 1937, 4188, 1845, 4248, 1922, 4352, 2034, 4290, 1980, 4250}}},
+	{"MG", [][]int32{{4954, -1247, 5038, -1571, 5020, -1600, 4986, -1541, 4967, -1571, 4977, -1688, 4710, -2494, 4541, -2560, 4404, -2499, 4335, -2278, 4343, -2134, 4389, -2116, 4446, -1944, 4396, -1741, 4445, -1622, 4631, -1578, 4771, -1459, 4787, -1366, 4829, -1378, 4919, -1204, 4954, -1247}}},
+	{"MK", [][]int32{{2059, 4186, 2238, 4232, 2288, 4200, 2295, 4134, 2102, 4084, 2061, 4109, 2059, 4186}}},
+	{"ML", [][]int32{{-1217, 1462, -1167, 1539, -1065, 1513, -955, 1549, -554, 1550, -532, 1620, -645, 2496, -492, 2497, 315, 1969, 316, 1906, 427, 1916, 427, 1685, 364, 1557, 139, 1532, 102, 1497, -107, 1497, -310, 1354, -401, 1347, -522, 1171, -540, 1037, -605, 1010, -621, 1052, -685, 1014, -803, 1021, -913, 1231, -1017, 1184, -1146, 1208, -1217, 1462}}},
+	{"MM", [][]int32{{9954, 2019, 9825, 1971, 9738, 1845, 9890, 1618, 9819, 1512, 9910, 1383, 9959, 1189, 9855, 993, 9851, 1312, 9716, 1693, 9537, 1571, 9419, 1604, 9453, 1728, 9432, 1821, 9354, 1937, 9366, 1973, 9237, 2067, 9230, 2148, 9265, 2132, 9267, 2204, 9317, 2228, 9333, 2408, 9411, 2385, 9512, 2657, 9642, 2726, 9713, 2708, 9733, 2826, 9791, 2834, 9868, 2751, 9867, 2592, 9772, 2508, 9760, 2390, 9866, 2406, 9890, 2314, 9953, 2295, 9924, 2212, 10042, 2156, 10115, 2185, 10118, 2144, 9954, 2019}}},
+	{"MN", [][]int32{{8775, 4930, 9223, 5080, 9726, 4973, 9823, 5042, 9783, 5101, 9886, 5205, 10207, 5126, 10226, 5051, 10368, 5009, 10689, 5027, 10848, 4928, 11066, 4913, 11290, 4954, 11436, 5025, 11549, 4981, 11668, 4989, 11549, 4814, 11574, 4773, 11730, 4770, 11806, 4807, 11977, 4705, 11966, 4669, 11742, 4667, 11346, 4481, 11187, 4510, 11135, 4446, 11183, 4374, 11041, 4287, 10613, 4213, 10496, 4160, 10085, 4266, 9635, 4273, 9531, 4424, 9348, 4498, 9095, 4529, 9059, 4572, 9097, 4689, 9028, 4769, 8801, 4860, 8775, 4930}}},
+	{"MR", [][]int32{{-1217, 1462, -1344, 1604, -1458, 1660, -1646, 1614, -1628, 2009, -1706, 2100, -1685, 2133, -1293, 2133, -1287, 2328, -1194, 2337, -1197, 2593, -869, 2588, -868, 2740, -492, 2497, -645, 2496, -532, 1620, -554, 1550, -955, 1549, -1065, 1513, -1167, 1539, -1217, 1462}}},
+	{"MW", [][]int32{{3456, -1152, 3428, -1228, 3456, -1358, 3527, -1389, 3569, -1461, 3577, -1590, 3503, -1680, 3438, -1618, 3446, -1461, 3269, -1371, 3331, -1244, 3311, -1161, 3349, -1053, 3276, -923, 3374, -942, 3456, -1152}}},
+	{"MX", [][]int32{{-9714, 2587, -9770, 2427, -9770, 2190, -9590, 1883, -9443, 1814, -9077, 1928, -9028, 2100, -8854, 2149, -8681, 2133, -8784, 1826, -8849, 1849, -8885, 1788, -9100, 1782, -9100, 1725, -9145, 1725, -9046, 1607, -9175, 1607, -9223, 1454, -9388, 1594, -9469, 1620, -9656, 1565, -10350, 1829, -10499, 1932, -10573, 2043, -10540, 2053, -10527, 2142, -10603, 2277, -10840, 2517, -10926, 2558, -10929, 2644, -11039, 2716, -11064, 2786, -11223, 2895, -11315, 3117, -11478, 3180, -11467, 3016, -11162, 2666, -11066, 2430, -11017, 2427, -10941, 2336, -11003, 2282, -11030, 2343, -11218, 2474, -11230, 2601, -11506, 2772, -11457, 2774, -11416, 2857, -11552, 2956, -11713, 3254, -11472, 3272, -11102, 3133, -10824, 3134, -10824, 3175, -10651, 3175, -10394, 2927, -10311, 2897, -10248, 2976, -10096, 2938, -9902, 2637, -9714, 2587}}},
+	{"MY", [][]int32{{10108, 620, 10115, 569, 10214, 622, 10296, 552, 10338, 486, 10350, 279, 10423, 129, 10352, 123, 10139, 276, 10020, 531, 10009, 646, 10108, 620}}},
+	{"MY", [][]int32{{11862, 448, 11788, 414, 11587, 431, 11462, 143, 11286, 150, 11180, 90, 11051, 77, 10983, 134, 10966, 201, 11040, 166, 11117, 185, 11137, 270, 11300, 310, 11420, 453, 11466, 401, 11535, 432, 11545, 545, 11673, 692, 11713, 693, 11769, 599, 11918, 541, 11911, 502, 11844, 497, 11862, 448}}},
+	{"MZ", [][]int32{{3456, -1152, 3747, -1157, 4032, -1032, 4078, -1469, 3945, -1672, 3741, -1759, 3479, -1978, 3470, -2050, 3556, -2209, 3546, -2412, 3301, -2536, 3257, -2573, 3283, -2674, 3207, -2673, 3193, -2437, 3119, -2225, 3266, -2030, 3285, -1671, 3117, -1586, 3034, -1588, 3018, -1480, 3321, -1397, 3446, -1461, 3438, -1618, 3503, -1680, 3577, -1590, 3569, -1461, 3527, -1389, 3456, -1358, 3428, -1228, 3456, -1152}}},
+	{"NA", [][]int32{{1634, -2858, 1521, -2709, 1426, -2211, 1179, -1807, 1173, -1730, 1346, -1697, 1406, -1742, 1826, -1731, 1896, -1779, 2138, -1793, 2403, -1730, 2508, -1758, 2358, -1828, 2320, -1787, 2091, -1825, 2088, -2181, 1990, -2185, 1989, -2846, 1846, -2905, 1739, -2878, 1682, -2808, 1634, -2858}}},
+	{"NC", [][]int32{{16578, -2108, 16712, -2216, 16674, -2240, 16547, -2168, 16403, -2011, 16578, -2108}}},
+	{"NE", [][]int32{{215, 1194, 218, 1263, 102, 1285, 37, 1493, 364, 1557, 427, 1685, 427, 1916, 568, 1960, 1200, 2347, 1358, 2304, 1414, 2249, 1485, 2286, 1510, 2131, 1590, 2039, 1525, 1663, 1397, 1568, 1354, 1437, 1395, 1335, 1460, 1333, 1418, 1248, 1308, 1360, 1230, 1304, 1099, 1339, 901, 1283, 780, 1334, 682, 1312, 544, 1387, 411, 1353, 361, 1166, 285, 1224, 215, 1194}}},
+	{"NG", [][]int32{{850, 477, 590, 426, 433, 627, 269, 626, 272, 851, 371, 1006, 368, 1255, 411, 1353, 544, 1387, 682, 1312, 780, 1334, 901, 1283, 1099, 1339, 1230, 1304, 1332, 1356, 1458, 1209, 1442, 1157, 1357, 1080, 1175, 698, 1106, 664, 1012, 704, 923, 644, 850, 477}}},
+	{"NI", [][]int32{{-8571, 1109, -8767, 1291, -8673, 1326, -8676, 1375, -8580, 1384, -8492, 1479, -8315, 1500, -8386, 1137, -8366, 1094, -8571, 1109}}},
+	{"NL", [][]int32{{607, 5351, 691, 5348, 709, 5314, 659, 5185, 599, 5185, 616, 5080, 497, 5148, 331, 5135, 471, 5309, 607, 5351}}},
+	{"NO", [][]int32{{2817, 7119, 3129, 7045, 3001, 7019, 3110, 6956, 2859, 6906, 2902, 6977, 2773, 7016, 2618, 6983, 2569, 6909, 2474, 6865, 2236, 6884, 2124, 6937, 2003, 6907, 1988, 6841, 1799, 6857, 1773, 6801, 1677, 6801, 1356, 6479, 1392, 6445, 1357, 6405, 1258, 6407, 1193, 6313, 1199, 6180, 1263, 6129, 1230, 6012, 1103, 5886, 1036, 5947, 838, 5831, 705, 5808, 567, 5859, 499, 6197, 1053, 6449, 1476, 6781, 1918, 6982, 2302, 7020, 2455, 7103, 2817, 7119}}},
+	{"NP", [][]int32{{8812, 2788, 8806, 2641, 8723, 2640, 8330, 2736, 8009, 2879, 8048, 2973, 8153, 3042, 8582, 2820, 8812, 2788}}},
+	{"NZ", [][]int32{{17302, -4092, 17325, -4133, 17396, -4093, 17425, -4135, 17425, -4177, 17271, -4337, 17308, -4385, 17145, -4424, 17062, -4591, 16933, -4664, 16668, -4622, 16651, -4585, 16705, -4511, 17052, -4303, 17210, -4096, 17280, -4049, 17302, -4092}}},
+	{"NZ", [][]int32{{17461, -3616, 17534, -3721, 17536, -3653, 17581, -3680, 17596, -3756, 17676, -3788, 17852, -3770, 17797, -3917, 17721, -3915, 17601, -4129, 17524, -4169, 17465, -4128, 17523, -4046, 17490, -3991, 17382, -3951, 17457, -3880, 17470, -3738, 17264, -3453, 17433, -3527, 17461, -3616}}},
+	{"OM", [][]int32{{5886, 2111, 5849, 2043, 5783, 2024, 5769, 1894, 5661, 1857, 5628, 1788, 5566, 1788, 5479, 1695, 5311, 1665, 5200, 1900, 5500, 2000, 5567, 2200, 5523, 2311, 5598, 2413, 5589, 2492, 5640, 2492, 5740, 2388, 5873, 2357, 5981, 2253, 5886, 2111}}},
+	{"OM", [][]int32{{5639, 2590, 5607, 2606, 5636, 2640, 5639, 2590}}},
+	{"PA", [][]int32{{-7788, 722, -7843, 805, -7818, 832, -7912, 900, -8038, 830, -8000, 755, -8042, 727, -8089, 722, -8106, 782, -8152, 771, -8172, 811, -8285, 807, -8293, 948, -8144, 879, -7957, 961, -7806, 925, -7735, 867, -7724, 794, -7788, 722}}},
+	{"PE", [][]int32{{-6959, -1758, -7037, -1835, -7601, -1465, -7626, -1354, -7976, -719, -8125, -614, -8093, -569, -8141, -474, -8110, -404, -8030, -340, -8044, -443, -7962, -445, -7921, -496, -7784, -300, -7664, -261, -7554, -156, -7511, -6, -7366, -126, -7307, -231, -7081, -226, -7005, -273, -7069, -374, -6989, -430, -7079, -425, -7289, -527, -7312, -663, -7399, -752, -7302, -903, -7323, -946, -7256, -952, -7218, -1005, -7130, -1008, -7048, -949, -7055, -1101, -6953, -1095, -6867, -1256, -6934, -1495, -6896, -1650, -6959, -1758}}},
+	{"PG", [][]int32{{15588, -682, 15517, -654, 15451, -514, 15602, -654, 15588, -682}}},
+	{"PG", [][]int32{{15198, -548, 15024, -632, 14832, -575, 14840, -544, 14985, -551, 15014, -500, 15024, -553, 15081, -546, 15165, -476, 15154, -417, 15234, -431, 15198, -548}}},
+	{"PG", [][]int32{{14719, -739, 14873, -910, 14931, -907, 14927, -951, 15080, -1029, 15069, -1058, 14791, -1013, 14605, -807, 14474, -763, 14329, -825, 14341, -898, 14263, -933, 14103, -912, 14100, -260, 14458, -386, 14583, -488, 14598, -547, 14765, -608, 14789, -661, 14697, -672, 14719, -739}}},
+	{"PG", [][]int32{{15314, -450, 15283, -477, 15241, -379, 15066, -274, 15094, -250, 15224, -324, 15314, -450}}},
+	{"PH", [][]int32{{12638, 841, 12654, 719, 12620, 627, 12583, 729, 12536, 679, 12568, 605, 12540, 558, 12422, 616, 12394, 689, 12424, 736, 12361, 783, 12209, 690, 12192, 719, 12231, 803, 12349, 869, 12384, 824, 12460, 851, 12476, 896, 12547, 899, 12541, 976, 12622, 929, 12638, 841}}},
+	{"PH", [][]int32{{12398, 1028, 12300, 902, 12238, 971, 12295, 1088, 12350, 1094, 12334, 1027, 12408, 1123, 12398, 1028}}},
+	{"PH", [][]int32{{11850, 932, 11717, 837, 11951, 1137, 11969, 1055, 11850, 932}}},
+	{"PH", [][]int32{{12188, 1189, 12312, 1158, 12310, 1117, 12200, 1044, 12188, 1189}}},
+	{"PH", [][]int32{{12550, 1216, 12578, 1105, 12501, 1131, 12528, 1036, 12480, 1013, 12430, 1150, 12489, 1142, 12488, 1179, 12427, 1256, 12523, 1254, 12550, 1216}}},
+	{"PH", [][]int32{{12153, 1307, 12126, 1221, 12032, 1347, 12153, 1307}}},
+	{"PH", [][]int32{{12132, 1850, 12194, 1822, 12225, 1848, 12252, 1709, 12225, 1626, 12166, 1593, 12173, 1433, 12395, 1378, 12408, 1254, 12293, 1355, 12267, 1319, 12203, 1378, 12063, 1386, 12099, 1453, 12069, 1476, 12056, 1440, 12007, 1497, 11988, 1636, 12029, 1603, 12072, 1851, 12132, 1850}}},
+	{"PK", [][]int32{{7516, 3713, 7590, 3667, 7619, 3590, 7784, 3549, 7687, 3465, 7424, 3475, 7375, 3432, 7445, 3276, 7526, 3227, 7441, 3169, 7442, 3098, 7178, 2791, 7062, 2799, 6951, 2694, 7017, 2649, 7104, 2436, 6884, 2436, 6818, 2369, 6744, 2394, 6637, 2543, 6150, 2508, 6187, 2624, 6332, 2676, 6276, 2738, 6273, 2826, 6177, 2870, 6087, 2983, 6255, 2932, 6635, 2989, 6638, 3074, 6694, 3130, 6932, 3190, 6926, 3250, 7032, 3336, 6993, 3402, 7088, 3399, 7161, 3515, 7126, 3607, 7185, 3651, 7516, 3713}}},
+	{"PL", [][]int32{{1502, 5111, 1407, 5298, 1435, 5325, 1412, 5376, 1762, 5485, 1870, 5444, 2324, 5422, 2380, 5269, 2320, 5249, 2403, 5071, 2252, 4948, 2278, 4903, 2161, 4947, 1891, 4944, 1755, 5036, 1672, 5022, 1502, 5111}}},
+	{"PR", [][]int32{{-6628, 1851, -6559, 1823, -6718, 1795, -6710, 1852, -6628, 1851}}},
+	{"PS", [][]int32{{3555, 3239, 3540, 3149, 3493, 3135, 3518, 3253, 3555, 3239}}},
+	{"PT", [][]int32{{-903, 4188, -826, 4228, -801, 4179, -667, 4188, -639, 4138, -685, 4111, -707, 3971, -750, 3963, -703, 3808, -786, 3684, -890, 3687, -884, 3827, -953, 3874, -877, 4076, -903, 4188}}},
+	{"PY", [][]int32{{-6269, -2225, -6179, -1963, -5912, -1936, -5818, -1987, -5794, -2209, -5580, -2236, -5540, -2396, -5429, -2402, -5479, -2662, -5649, -2755, -5862, -2712, -5763, -2560, -5778, -2516, -6085, -2388, -6269, -2225}}},
+	{"QA", [][]int32{{5081, 2475, 5074, 2548, 5129, 2611, 5161, 2522, 5139, 2463, 5081, 2475}}},
+	{"RO", [][]int32{{2271, 4788, 2487, 4774, 2692, 4812, 2813, 4681, 2823, 4549, 2915, 4546, 2963, 4504, 2884, 4491, 2856, 4371, 2724, 4418, 2557, 4369, 2294, 4382, 2247, 4441, 2271, 4458, 2156, 4477, 2022, 4613, 2102, 4632, 2210, 4767, 2271, 4788}}},
+	{"RS", [][]int32{{2087, 4542, 2215, 4448, 2271, 4458, 2241, 4401, 2299, 4321, 2238, 4232, 2158, 4225, 2178, 4268, 2081, 4327, 2026, 4281, 1922, 4352, 1960, 4404, 1912, 4442, 1939, 4524, 1883, 4591, 2022, 4613, 2087, 4542}}},
+	{"RU", [][]int32{{14365, 5075, 14465, 4898, 14317, 4931, 14256, 4786, 14353, 4684, 14351, 4614, 14275, 4674, 14209, 4597, 14218, 5095, 14159, 5194, 14168, 5330, 14261, 5376, 14221, 5423, 14265, 5437, 14365, 5075}}},
+	{"RU", [][]int32{{2273, 5433, 1966, 5443, 1989, 5487, 2127, 5519, 2276, 5486, 2273, 5433}}},
+	{"RU", [][]int32{{-17501, 6658, -17434, 6634, -17457, 6706, -17186, 6691, -16990, 6598, -17253, 6544, -17256, 6446, -17296, 6425, -17598, 6492, -17621, 6536, -17836, 6539, -17890, 6574, -17869, 6611, -17988, 6587, -17943, 6540, -18000, 6498, -18000, 6896, -17493, 6721, -17501, 6658}}},
+	{"RU", [][]int32{{18000, 7083, 17890, 7078, 17873, 7110, 18000, 7152, 18000, 7083}}},
+	{"RU", [][]int32{{-17869, 7089, -18000, 7083, -18000, 7152, -17758, 7127, -17869, 7089}}},
+	{"RU", [][]int32{{14360, 7321, 13986, 7337, 14206, 7386, 14360, 7321}}},
+	{"RU", [][]int32{{15073, 7508, 14958, 7469, 14612, 7517, 14636, 7550, 15073, 7508}}},
+	{"RU", [][]int32{{14509, 7556, 14430, 7482, 13896, 7461, 13697, 7526, 13751, 7595, 13883, 7614, 14509, 7556}}},
+	{"RU", [][]int32{{5754, 7072, 5368, 7076, 5341, 7121, 5160, 7147, 5146, 7201, 5248, 7223, 5244, 7277, 5443, 7363, 5351, 7375, 5590, 7463, 5563, 7508, 6117, 7625, 6816, 7694, 6885, 7654, 6158, 7526, 5848, 7431, 5542, 7237, 5562, 7154, 5754, 7072}}},
+	{"RU", [][]int32{{10697, 7697, 10724, 7648, 11108, 7671, 11413, 7585, 11389, 7533, 10940, 7418, 11302, 7398, 11353, 7334, 11557, 7375, 11878, 7359, 11902, 7312, 12320, 7297, 12326, 7374, 12698, 7357, 12859, 7304, 12905, 7240, 12846, 7198, 13129, 7079, 13225, 7184, 13386, 7139, 13987, 7149, 13915, 7242, 14047, 7285, 14950, 7220, 15297, 7084, 15900, 7087, 15983, 7045, 15971, 6972, 16094, 6944, 16784, 6958, 16958, 6869, 17082, 6901, 17001, 6965, 17045, 7010, 17572, 6988, 18000, 6896, 18000, 6498, 17871, 6453, 17741, 6461, 17937, 6298, 17923, 6230, 17736, 6252, 17368, 6165, 17033, 5988, 16890, 6057, 16629, 5979, 16584, 6016, 16488, 5973, 16354, 5987, 16202, 5824, 16205, 5784, 16319, 5762, 16306, 5616, 16213, 5612, 16170, 5529, 16212, 5486, 16037, 5434, 16002, 5320, 15853, 5296, 15823, 5194, 15679, 5101, 15543, 5538, 15591, 5677, 15676, 5736, 15681, 5783, 15836, 5806, 16367, 6114, 16447, 6255, 16326, 6247, 16266, 6164, 16012, 6054, 15930, 6177, 15672, 6143, 15422, 5976, 15504, 5914, 15127, 5878, 15134, 5950, 14978, 5966, 14854, 5916, 14220, 5904, 13513, 5473, 13670, 5460, 13719, 5398, 13816, 5376, 13880, 5425, 13990, 5419, 14135, 5309, 14138, 5224, 14060, 5124, 14006, 4845, 13487, 4340, 13354, 4281, 13228, 4328, 13078, 4222, 13063, 4290, 13114, 4293, 13129, 4411, 13103, 4497, 13188, 4532, 13310, 4514, 13503, 4848, 13251, 4779, 13099, 4779, 13058, 4873, 12940, 4944, 12766, 4976, 12595, 5279, 12507, 5316, 12357, 5346, 12100, 5325, 12018, 5275, 12073, 5252, 12074, 5196, 11928, 5058, 11929, 5014, 11788, 4951, 11436, 5025, 11290, 4954, 11066, 4913, 10848, 4928, 10689, 5027, 10368, 5009, 10226, 5051, 10207, 5126, 9886, 5205, 9783, 5101, 9823, 5042, 9726, 4973, 9223, 5080, 8736, 4921, 8683, 4983, 8554, 4969, 8338, 5107, 8195, 5081, 8057, 5139, 8004, 5086, 7780, 5340, 7653, 5418, 7689, 5449, 7343, 5349, 7351, 5404, 7222, 5438, 7118, 5413, 7087, 5517, 6907, 5539, 6518, 5435, 6144, 5401, 6098, 5366, 6170, 5298, 6074, 5272, 6093, 5245, 5997, 5196, 6159, 5127, 6134, 5080, 5993, 5084, 5964, 5055, 5836, 5106, 5678, 5104, 5572, 5062, 5233, 5172, 5077, 5169, 4870, 5061, 4858, 4987, 4755, 5045, 4647, 4839, 4732, 4772, 4806, 4774, 4869, 4708, 4859, 4656, 4910, 4640, 4668, 4461, 4858, 4181, 4782, 4115, 4547, 4250, 3996, 4343, 3668, 4524, 3740, 4540, 3823, 4624, 3767, 4664, 3915, 4704, 3822, 4710, 3826, 4755, 3974, 4790, 4007, 4960, 3536, 5058, 3502, 5121, 3422, 5126, 3439, 5177, 3375, 5234, 3179, 5210, 3131, 5307, 3269, 5335, 3076, 5481, 3087, 5555, 2818, 5617, 2777, 5724, 2729, 5747, 2772, 5779, 2742, 5872, 2798, 5948, 2912, 6003, 2807, 6050, 3152, 6287, 3004, 6355, 3044, 6420, 2954, 6495, 3022, 6581, 2905, 6694, 2998, 6770, 2845, 6836, 2859, 6906, 3213, 6991, 3378, 6930, 3651, 6906, 4106, 6746, 4113, 6679, 3838, 6600, 3318, 6663, 3481, 6590, 3494, 6441, 3701, 6385, 3714, 6433, 3654, 6476, 3718, 6514, 3959, 6452, 4044, 6476, 3976, 6550, 4209, 6648, 4395, 6607, 4453, 6676, 4370, 6735, 4419, 6795, 4345, 6857, 4625, 6825, 4682, 6769, 4556, 6757, 4556, 6701, 4635, 6667, 4789, 6688, 4814, 6752, 5372, 6886, 5447, 6881, 5349, 6820, 5473, 6810, 5880, 6888, 5994, 6828, 6108, 6894, 6003, 6952, 6055, 6985, 6350, 6955, 6851, 6809, 6918, 6862, 6814, 6936, 6693, 6945, 6726, 6993, 6669, 7103, 6994, 7304, 7259, 7278, 7280, 7222, 7185, 7141, 7279, 7039, 7256, 6902, 7367, 6841, 7128, 6632, 7242, 6617, 7505, 6776, 7447, 6833, 7494, 6899, 7384, 6907, 7360, 6963, 7440, 7063, 7310, 7145, 7489, 7212, 7466, 7283, 7568, 7230, 7529, 7134, 7636, 7115, 7590, 7187, 7758, 7227, 7965, 7232, 8150, 7175, 8061, 7258, 8051, 7365, 8682, 7394, 8601, 7446, 8717, 7512, 9323, 7605, 9668, 7592, 10076, 7643, 10199, 7729, 10435, 7770, 10607, 7737, 10471, 7713, 10697, 7697}}},
+	{"RU", [][]int32{{10508, 7831, 9944, 7792, 10126, 7923, 10209, 7935, 10537, 7871, 10508, 7831}}},
+	{"RU", [][]int32{{5114, 8055, 4759, 8001, 4650, 8025, 4707, 8056, 4485, 8059, 5152, 8070, 5114, 8055}}},
+	{"RU", [][]int32{{9994, 7888, 9497, 7904, 9331, 7943, 9255, 8014, 9118, 8034, 9594, 8125, 10019, 7978, 9994, 7888}}},
+	{"RW", [][]int32{{3042, -113, 3076, -229, 2994, -235, 2963, -292, 2902, -284, 2929, -162, 3042, -113}}},
+	{"SA", [][]int32{{4278, 1635, 4094, 1949, 3914, 2129, 3907, 2258, 3849, 2369, 3748, 2429, 3513, 2806, 3463, 2806, 3496, 2936, 3607, 2920, 3800, 3051, 3700, 3151, 3920, 3216, 4040, 3189, 4471, 2918, 4746, 2900, 4771, 2853, 4842, 2855, 4881, 2769, 5015, 2669, 5024, 2561, 5081, 2475, 5139, 2463, 5200, 2300, 5521, 2271, 5567, 2200, 5500, 2000, 5200, 1900, 4912, 1862, 4700, 1695, 4675, 1728, 4338, 1758, 4322, 1667, 4278, 1635}}},
+	{"SB", [][]int32{{16212, -1048, 16240, -1083, 16170, -1082, 16132, -1020, 16212, -1048}}},
+	{"SB", [][]int32{{16085, -987, 15985, -979, 15970, -924, 16085, -987}}},
+	{"SB", [][]int32{{16168, -960, 16058, -832, 16092, -832, 16168, -960}}},
+	{"SB", [][]int32{{15988, -834, 15992, -854, 15913, -811, 15859, -775, 15821, -742, 15836, -732, 15882, -756, 15964, -802, 15988, -834}}},
+	{"SB", [][]int32{{15754, -735, 15734, -740, 15690, -718, 15649, -677, 15654, -660, 15714, -702, 15754, -735}}},
+	{"SD", [][]int32{{3396, 946, 3321, 1072, 3321, 1218, 3274, 1225, 3207, 1197, 3240, 1108, 3135, 981, 3084, 971, 3000, 1029, 2897, 940, 2675, 947, 2579, 1041, 2507, 1027, 2454, 892, 2389, 862, 2346, 895, 2355, 1009, 2229, 1265, 2194, 1259, 2302, 1568, 2389, 1561, 2385, 2000, 2500, 2000, 2500, 2200, 3687, 2200, 3748, 1861, 3841, 1800, 3685, 1696, 3627, 1356, 3426, 1063, 3396, 946}}},
+	{"SE", [][]int32{{2218, 6572, 2121, 6503, 2137, 6441, 1785, 6275, 1712, 6134, 1879, 6008, 1787, 5895, 1683, 5872, 1588, 5610, 1467, 5620, 1410, 5541, 1294, 5536, 1103, 5886, 1230, 6012, 1263, 6129, 1199, 6180, 1193, 6313, 1258, 6407, 1357, 6405, 1392, 6445, 1356, 6479, 1677, 6801, 1773, 6801, 1799, 6857, 1988, 6841, 2003, 6907, 2354, 6794, 2357, 6640, 2390, 6601, 2218, 6572}}},
+	{"SI", [][]int32{{1381, 4651, 1620, 4685, 1656, 4650, 1577, 4624, 1533, 4545, 1372, 4550, 1381, 4651}}},
+	{"SJ", [][]int32{{2472, 7785, 2249, 7744, 2073, 7768, 2142, 7794, 2081, 7825, 2288, 7845, 2472, 7785}}},
+	{"SJ", [][]int32{{1825, 7970, 2154, 7896, 1903, 7856, 1847, 7783, 1759, 7764, 1712, 7681, 1591, 7677, 1376, 7738, 1467, 7774, 1122, 7887, 1044, 7965, 1317, 8001, 1372, 7966, 1514, 7967, 1552, 8002, 1699, 8005, 1825, 7970}}},
+	{"SJ", [][]int32{{2545, 8041, 2741, 8006, 2592, 7952, 2302, 7940, 2008, 7957, 1737, 8032, 2292, 8066, 2545, 8041}}},
+	{"SK", [][]int32{{1885, 4950, 1983, 4922, 2161, 4947, 2256, 4909, 2187, 4832, 2080, 4862, 1786, 4776, 1698, 4812, 1710, 4882, 1885, 4950}}},
+	{"SL", [][]int32{{-1144, 679, -1295, 780, -1325, 890, -1192, 1005, -1112, 1005, -1051, 835, -1023, 841, -1144, 679}}},
+	{"SN", [][]int32{{-1671, 1359, -1763, 1473, -1612, 1646, -1458, 1660, -1344, 1604, -1217, 1462, -1151, 1244, -1668, 1238, -1684, 1315, -1384, 1351, -1508, 1388, -1671, 1359}}},
+	{"SO", [][]int32{{4894, 945, 4779, 800, 4695, 800, 4368, 918, 4256, 1057, 4315, 1146, 4412, 1045, 4895, 1141, 4894, 945}}},
+	{"SO", [][]int32{{4973, 1158, 5111, 1202, 5105, 1064, 4859, 534, 4656, 286, 4314, 29, 4159, -168, 4099, -86, 4098, 278, 4213, 423, 4277, 425, 4366, 496, 4496, 500, 4894, 945, 4894, 1139, 4973, 1158}}},
+	{"SR", [][]int32{{-5715, 597, -5396, 576, -5448, 490, -5401, 362, -5452, 231, -5597, 251, -5600, 182, -5654, 190, -5728, 333, -5760, 333, -5804, 406, -5715, 597}}},
+	{"SS", [][]int32{{3396, 946, 3383, 838, 3329, 835, 3295, 778, 3408, 723, 3530, 551, 3339, 379, 3188, 356, 3125, 378, 3083, 351, 2972, 460, 2798, 441, 2511, 783, 2389, 862, 2454, 892, 2507, 1027, 2579, 1041, 2675, 947, 2897, 940, 3000, 1029, 3084, 971, 3135, 981, 3240, 1108, 3207, 1197, 3274, 1225, 3321, 1218, 3321, 1072, 3396, 946}}},
+	{"SV", [][]int32{{-8779, 1338, -8848, 1316, -9010, 1374, -8935, 1442, -8850, 1385, -8786, 1389, -8779, 1338}}},
+	{"SY", [][]int32{{3879, 3338, 3683, 3231, 3570, 3272, 3607, 3382, 3661, 3420, 3600, 3464, 3591, 3541, 3674, 3682, 3952, 3672, 4235, 3723, 4129, 3636, 4101, 3442, 3879, 3338}}},
+	{"SZ", [][]int32{{3207, -2673, 3128, -2729, 3069, -2674, 3104, -2573, 3184, -2584, 3207, -2673}}},
+	{"TD", [][]int32{{1450, 1286, 1460, 1333, 1395, 1335, 1354, 1437, 1397, 1568, 1525, 1663, 1590, 2039, 1510, 2131, 1485, 2286, 1586, 2341, 2384, 1958, 2389, 1561, 2302, 1568, 2194, 1259, 2229, 1265, 2286, 1114, 2172, 1057, 2100, 948, 1881, 898, 1891, 863, 1796, 789, 1528, 742, 1498, 880, 1395, 955, 1417, 1002, 1547, 998, 1450, 1286}}},
+	{"TF", [][]int32{{6894, -4863, 7056, -4926, 7028, -4971, 6875, -4978, 6894, -4863}}},
+	{"TG", [][]int32{{187, 614, 106, 593, 57, 691, 71, 831, 2, 1102, 90, 1100, 77, 1047, 143, 983, 187, 614}}},
+	{"TH", [][]int32{{10258, 1219, 10083, 1263, 10098, 1341, 10010, 1341, 9915, 996, 9922, 924, 9987, 921, 10046, 743, 10214, 622, 10115, 569, 10108, 620, 10009, 646, 9850, 838, 9834, 779, 9815, 835, 9959, 1189, 9910, 1383, 9819, 1512, 9890, 1618, 9738, 1845, 9825, 1971, 10012, 2042, 10061, 1951, 10128, 1946, 10106, 1751, 10211, 1811, 10300, 1796, 10320, 1831, 10396, 1824, 10472, 1743, 10478, 1644, 10559, 1557, 10554, 1472, 10522, 1427, 10299, 1423, 10235, 1339, 10258, 1219}}},
+	{"TJ", [][]int32{{7101, 4024, 7065, 3994, 6956, 4010, 6946, 3953, 7368, 3943, 7393, 3851, 7486, 3838, 7498, 3742, 7326, 3750, 7184, 3674, 7145, 3707, 7135, 3826, 7081, 3849, 7012, 3759, 6952, 3761, 6920, 3715, 6783, 3714, 6839, 3816, 6818, 3890, 6744, 3914, 6770, 3958, 6854, 3953, 6933, 4073, 7067, 4096, 7046, 4050, 7101, 4024}}},
+	{"TL", [][]int32{{12497, -889, 12595, -843, 12734, -840, 12509, -939, 12497, -889}}},
+	{"TM", [][]int32{{6121, 3565, 6112, 3649, 5733, 3803, 5551, 3796, 5392, 3720, 5388, 3895, 5310, 3929, 5336, 3998, 5269, 4003, 5292, 4088, 5386, 4063, 5474, 4095, 5372, 4212, 5292, 4187, 5281, 4114, 5250, 4178, 5408, 4232, 5546, 4126, 5710, 4132, 5693, 4183, 5863, 4275, 5998, 4222, 6008, 4143, 6047, 4122, 6188, 4108, 6237, 4005, 6417, 3889, 6655, 3797, 6652, 3736, 6575, 3766, 6475, 3711, 6455, 3631, 6319, 3586, 6298, 3540, 6121, 3565}}},
+	{"TN", [][]int32{{948, 3031, 906, 3210, 761, 3334, 752, 3410, 814, 3466, 842, 3695, 951, 3735, 1021, 3723, 1018, 3672, 1103, 3709, 1060, 3641, 1081, 3483, 1015, 3433, 1034, 3379, 1149, 3314, 1143, 3237, 995, 3138, 997, 3054, 948, 3031}}},
+	{"TR", [][]int32{{3691, 4134, 3835, 4095, 4037, 4101, 4262, 4158, 4358, 4109, 4366, 4025, 4479, 3971, 4411, 3943, 4423, 3797, 4477, 3717, 4278, 3739, 3952, 3672, 3674, 3682, 3615, 3582, 3578, 3627, 3616, 3665, 3471, 3680, 3403, 3622, 3251, 3611, 3170, 3664, 3062, 3668, 2970, 3614, 2873, 3668, 2764, 3666, 2632, 3821, 2680, 3899, 2617, 3946, 2728, 4042, 2882, 4046, 2924, 4122, 3115, 4109, 3351, 4202, 3517, 4204, 3691, 4134}}},
+	{"TR", [][]int32{{2719, 4069, 2636, 4015, 2606, 4082, 2660, 4156, 2612, 4183, 2800, 4201, 2899, 4130, 2719, 4069}}},
+	{"TT", [][]int32{{-6168, 1076, -6090, 1086, -6094, 1011, -6195, 1009, -6168, 1076}}},
+	{"TW", [][]int32{{12178, 2439, 12075, 2197, 12011, 2356, 12150, 2530, 12195, 2500, 12178, 2439}}},
+	{"TZ", [][]int32{{3390, -95, 3770, -310, 3777, -368, 3920, -468, 3874, -591, 3880, -648, 3944, -684, 3919, -849, 4032, -1032, 3952, -1090, 3651, -1172, 3456, -1152, 3374, -942, 3074, -834, 2962, -652, 2934, -450, 3075, -336, 3047, -241, 3082, -170, 3042, -113, 3390, -95}}},
+	{"UA", [][]int32{{3179, 5210, 3375, 5234, 3439, 5177, 3422, 5126, 3502, 5121, 3536, 5058, 4007, 4960, 3974, 4790, 3877, 4783, 3822, 4710, 3496, 4627, 3502, 4565, 3653, 4547, 3633, 4511, 3388, 4436, 3333, 4456, 3355, 4503, 3245, 4533, 3359, 4585, 3174, 4633, 3168, 4671, 3075, 4658, 2960, 4529, 2823, 4549, 2886, 4644, 3002, 4642, 2867, 4812, 2752, 4847, 2487, 4774, 2271, 4788, 2209, 4842, 2278, 4903, 2252, 4948, 2392, 5042, 2353, 5158, 2533, 5191, 3056, 5132, 3093, 5204, 3179, 5210}}},
+	{"UG", [][]int32{{3187, -103, 2958, -134, 2988, 60, 3117, 220, 3077, 234, 3083, 351, 3339, 379, 3401, 425, 3504, 191, 3389, 11, 3390, -95, 3187, -103}}},
+	{"US", [][]int32{{-15554, 1908, -15594, 1906, -15586, 2027, -15481, 1951, -15554, 1908}}},
+	{"US", [][]int32{{-15608, 2064, -15641, 2057, -15659, 2078, -15670, 2086, -15671, 2093, -15661, 2101, -15626, 2092, -15600, 2076, -15608, 2064}}},
+	{"US", [][]int32{{-15676, 2118, -15679, 2107, -15733, 2110, -15725, 2122, -15676, 2118}}},
+	{"US", [][]int32{{-15765, 2132, -15771, 2126, -15778, 2128, -15813, 2131, -15825, 2154, -15829, 2158, -15803, 2172, -15794, 2165, -15765, 2132}}},
+	{"US", [][]int32{{-15935, 2198, -15946, 2188, -15980, 2207, -15975, 2214, -15960, 2224, -15937, 2221, -15935, 2198}}},
+	{"US", [][]int32{{-9482, 4939, -9433, 4867, -9164, 4814, -8838, 4830, -8414, 4651, -8255, 4535, -8214, 4357, -8312, 4208, -8269, 4168, -7894, 4286, -7917, 4347, -7872, 4363, -7682, 4363, -7487, 4500, -7151, 4501, -7066, 4546, -6924, 4745, -6779, 4707, -6779, 4570, -6696, 4481, -7012, 4368, -7083, 4234, -7050, 4181, -7008, 4178, -7019, 4215, -6988, 4192, -6997, 4164, -7371, 4093, -7194, 4093, -7395, 4075, -7418, 3971, -7491, 3894, -7553, 3950, -7506, 3840, -7594, 3722, -7572, 3794, -7623, 3832, -7635, 3915, -7633, 3808, -7699, 3824, -7630, 3792, -7573, 3555, -7636, 3481, -7906, 3349, -8134, 3144, -8131, 3004, -8006, 2688, -8013, 2582, -8068, 2508, -8117, 2520, -8271, 2750, -8293, 2910, -8410, 3009, -8511, 2964, -8640, 3040, -8918, 3032, -8959, 3016, -8941, 2916, -9323, 2978, -9469, 2948, -9714, 2783, -9714, 2587, -9753, 2584, -9902, 2637, -10096, 2938, -10248, 2976, -10311, 2897, -10394, 2927, -10651, 3175, -10824, 3175, -10824, 3134, -11102, 3133, -11472, 3272, -11713, 3254, -11852, 3403, -12062, 3461, -12440, 4031, -12421, 4200, -12453, 4277, -12390, 4552, -12469, 4818, -12312, 4804, -12259, 4710, -12234, 4736, -12284, 4900, -9516, 4900, -9516, 4938, -9482, 4939}}},
+	{"US", [][]int32{{-15301, 5712, -15401, 5673, -15467, 5746, -15323, 5797, -15214, 5759, -15301, 5712}}},
+	{"US", [][]int32{{-16558, 5991, -16619, 5975, -16746, 6021, -16567, 6029, -16558, 5991}}},
+	{"US", [][]int32{{-17173, 6378, -16869, 6330, -16953, 6298, -17155, 6332, -17173, 6378}}},
+	{"US", [][]int32{{-15507, 7115, -15434, 7070, -15221, 7083, -15074, 7043, -14099, 6971, -14100, 6031, -13904, 6000, -13745, 5891, -13548, 5979, -13336, 5841, -13171, 5655, -13001, 5592, -12998, 5528, -13054, 5480, -13197, 5550, -13225, 5637, -13354, 5718, -13408, 5812, -13663, 5821, -13987, 5954, -14257, 6008, -14396, 6000, -14711, 6088, -14822, 6067, -14802, 5998, -15172, 5916, -15186, 5974, -15141, 6073, -15035, 6103, -15062, 6128, -15402, 5935, -15329, 5886, -15423, 5815, -15631, 5742, -15843, 5599, -16479, 5440, -16180, 5589, -16056, 5601, -15772, 5757, -15704, 5892, -15906, 5842, -15971, 5893, -15998, 5857, -16036, 5907, -16197, 5867, -16187, 5963, -16252, 5999, -16382, 5980, -16535, 6051, -16535, 6107, -16612, 6150, -16573, 6207, -16456, 6315, -16307, 6306, -16077, 6377, -16152, 6440, -16078, 6479, -16276, 6434, -16496, 6445, -16643, 6469, -16811, 6567, -16447, 6658, -16365, 6658, -16379, 6608, -16168, 6612, -16539, 6804, -16676, 6836, -16620, 6888, -16317, 6937, -16191, 7033, -15658, 7136, -15507, 7115}}},
+	{"UY", [][]int32{{-5763, -3022, -5698, -3011, -5379, -3205, -5321, -3273, -5365, -3320, -5337, -3377, -5381, -3440, -5494, -3495, -5622, -3486, -5782, -3446, -5843, -3391, -5763, -3022}}},
+	{"UZ", [][]int32{{6652, 3736, 6655, 3797, 6417, 3889, 6237, 4005, 6188, 4108, 6047, 4122, 6008, 4143, 5998, 4222, 5863, 4275, 5693, 4183, 5710, 4132, 5597, 4131, 5593, 4500, 5850, 4559, 6106, 4441, 6201, 4350, 6490, 4373, 6610, 4300, 6602, 4199, 6651, 4199, 6671, 4117, 6799, 4114, 6826, 4066, 7096, 4227, 7126, 4217, 7042, 4152, 7306, 4087, 7177, 4015, 7060, 4022, 7067, 4096, 6933, 4073, 6854, 3953, 6770, 3958, 6744, 3914, 6818, 3890, 6839, 3816, 6783, 3714, 6652, 3736}}},
+	{"VE", [][]int32{{-7133, 1178, -7195, 1142, -7163, 1045, -7207, 987, -7170, 907, -7126, 914, -7104, 986, -7140, 1097, -7016, 1138, -7029, 1185, -6994, 1216, -6958, 1146, -6888, 1144, -6819, 1055, -6623, 1065, -6489, 1008, -6432, 1064, -6188, 1072, -6273, 1042, -6239, 995, -6083, 938, -6067, 858, -5976, 837, -6055, 778, -6030, 704, -6116, 670, -6141, 596, -6060, 492, -6309, 377, -6482, 406, -6437, 380, -6427, 250, -6337, 220, -6555, 79, -6633, 72, -6781, 282, -6730, 332, -6782, 450, -6734, 610, -6939, 610, -7009, 696, -7196, 699, -7244, 742, -7279, 909, -7330, 915, -7291, 1045, -7197, 1161, -7133, 1178}}},
+	{"VN", [][]int32{{10805, 2155, 10672, 2070, 10566, 1906, 10888, 1528, 10934, 1343, 10920, 1167, 10516, 860, 10480, 924, 10508, 992, 10433, 1049, 10625, 1096, 10581, 1157, 10749, 1234, 10756, 1520, 10509, 1867, 10390, 1927, 10482, 1989, 10444, 2076, 10320, 2077, 10217, 2246, 10448, 2282, 10533, 2335, 10673, 2279, 10657, 2222, 10704, 2181, 10805, 2155}}},
+	{"VU", [][]int32{{16784, -1647, 16752, -1660, 16722, -1589, 16784, -1647}}},
+	{"VU", [][]int32{{16711, -1493, 16727, -1574, 16679, -1567, 16663, -1463, 16711, -1493}}},
+	{"XK", [][]int32{{2076, 4205, 2059, 4186, 2007, 4259, 2081, 4327, 2178, 4268, 2158, 4225, 2076, 4205}}},
+	{"YE", [][]int32{{5311, 1665, 5239, 1638, 5217, 1560, 4957, 1471, 4868, 1400, 4418, 1259, 4348, 1264, 4260, 1521, 4338, 1758, 4675, 1728, 4700, 1695, 4912, 1862, 5200, 1900, 5311, 1665}}},
+	{"ZA", [][]int32{{3152, -2926, 3006, -3114, 2746, -3323, 2578, -3394, 2257, -3386, 1962, -3482, 1838, -3414, 1793, -3261, 1825, -3243, 1822, -3166, 1634, -2858, 1682, -2808, 1739, -2878, 1846, -2905, 1989, -2846, 1990, -2477, 2076, -2587, 2089, -2683, 2161, -2673, 2331, -2527, 2421, -2567, 2566, -2549, 2594, -2470, 2649, -2462, 2802, -2283, 2984, -2210, 3119, -2225, 3193, -2437, 3184, -2584, 3104, -2573, 3069, -2674, 3128, -2729, 3207, -2673, 3283, -2674, 3246, -2830, 3152, -2926}, {2898, -2896, 2854, -2865, 2807, -2885, 2700, -2988, 2775, -3065, 2811, -3055, 2933, -2926, 2898, -2896}}},
+	{"ZM", [][]int32{{3276, -923, 3349, -1053, 3311, -1161, 3331, -1244, 3269, -1371, 3321, -1397, 3018, -1480, 3027, -1551, 2895, -1604, 2704, -1794, 2468, -1735, 2322, -1752, 2189, -1608, 2193, -1290, 2402, -1291, 2391, -1093, 2542, -1133, 2575, -1178, 2716, -1161, 2893, -1325, 2970, -1326, 2962, -1218, 2934, -1236, 2837, -1179, 2873, -853, 3035, -824, 3276, -923}}},
+	{"ZW", [][]int32{{3119, -2225, 2943, -2209, 2802, -2149, 2772, -2050, 2616, -1929, 2526, -1774, 2704, -1794, 2895, -1604, 3027, -1551, 3034, -1588, 3117, -1586, 3285, -1671, 3266, -2030, 3119, -2225}}},
+}
@@ -0,0 +1,136 @@
+package isocodes
+
+import (
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Code represents an enumerated ISO code type of the package,
+// such as CountryCode and CurrencyCode. It is intended to be used
+// as a type constraint by generic helpers like Parse and List
+// and can be implemented only by the package types.
+type Code interface {
+	~uint8
+	fmt.Stringer
+	json.Marshaler
+	encoding.TextMarshaler
+	driver.Valuer
+
+	// Name returns a name of the entity related to the code.
+	Name() string
+
+	// Number returns a numeric representation of the code.
+	Number() string
+
+	// Flag returns an emoji flag related to the code.
+	Flag() string
+
+	// IsValid reports whether the code is known.
+	IsValid() bool
+
+	// IsZero reports whether the code is the zero value.
+	IsZero() bool
+
+	// format returns the format of the string representation of the code.
+	format() CodeFormat
+
+	// lookup returns the code for its exact string representation.
+	lookup(s string) (uint8, bool)
+
+	// reserved returns the code which the reserved string representation
+	// stands for, when the type has such codes.
+	reserved(s string) (uint8, bool)
+
+	// parseError returns ParseError for the invalid string representation of the code.
+	parseError(input string, err error) *ParseError
+}
+
+// ParseOption represents an option which changes the behaviour of parsing.
+// Options which are not applicable to the code type are ignored.
+type ParseOption func(o *parseOptions)
+
+// parseOptions holds parsing options.
+type parseOptions struct {
+	reserved bool
+}
+
+// WithReservedCodes allows parsing of the reserved codes which stand
+// for an officially assigned code, e.g. exceptionally reserved UK is parsed as GB,
+// and EL, which is used by the European Union for Greece, is parsed as GR.
+func WithReservedCodes() ParseOption { return func(o *parseOptions) { o.reserved = true } }
+
+// Parse takes case-insensitive string representation of the code
+// and returns the code of type T.
+func Parse[T Code](s string, opts ...ParseOption) (T, error) {
+	var (
+		zero    T
+		options parseOptions
+	)
+
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	if len(s) > zero.format().length() {
+		return zero, zero.parseError(s, ErrInvalidStringCode)
+	}
+
+	upper := strings.ToUpper(s)
+
+	c, ok := zero.lookup(upper)
+	if !ok && options.reserved {
+		c, ok = zero.reserved(upper)
+	}
+
+	if !ok {
+		return zero, zero.parseError(s, ErrInvalidStringCode)
+	}
+
+	return T(c), nil
+}
+
+// MustParse is like Parse but panics if the string can't be parsed.
+// It simplifies safe initialization of global variables.
+func MustParse[T Code](s string, opts ...ParseOption) T {
+	c, err := Parse[T](s, opts...)
+	if err != nil {
+		panic(err)
+	}
+
+	return c
+}
+
+// List returns a list of all valid codes of type T
+// sorted by their string representation.
+func List[T Code]() []T {
+	codes := make([]T, 0, maxCodes)
+
+	for i := 1; i < maxCodes; i++ {
+		if c := T(i); c.IsValid() {
+			codes = append(codes, c)
+		}
+	}
+
+	sort.Slice(codes, func(i, j int) bool {
+		return codes[i].String() < codes[j].String()
+	})
+
+	return codes
+}
+
+// maxCodes holds the maximum number of codes of a single type,
+// which is limited by the uint8 underlying type.
+const maxCodes = 256
+
+// length returns the maximum length of the string representation in the format.
+func (f CodeFormat) length() int {
+	if f == FormatAlpha2 {
+		return 2
+	}
+
+	return 3
+}
@@ -0,0 +1,134 @@
+package isocodes
+
+import (
+	"errors"
+	"sort"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	t.Run("CountryCode", func(t *testing.T) {
+		type tcase struct {
+			s       string
+			want    CountryCode
+			wantErr error
+		}
+
+		tests := map[string]tcase{
+			"Upper":   {"GB", GB, nil},
+			"Lower":   {"gb", GB, nil},
+			"TooLong": {"GBR", 0, ErrInvalidStringCode},
+			"Unknown": {"ZZ", 0, ErrInvalidStringCode},
+		}
+
+		for name, tc := range tests {
+			t.Run(name, func(t *testing.T) {
+				got, err := Parse[CountryCode](tc.s)
+				if tc.wantErr != nil {
+					if !errors.Is(err, tc.wantErr) {
+						t.Errorf("Parse() error = %v, wantErr %v", err, tc.wantErr)
+					}
+				} else {
+					if got != tc.want {
+						t.Errorf("Parse() got = %v, want %v", got, tc.want)
+					}
+				}
+			})
+		}
+	})
+
+	t.Run("CurrencyCode", func(t *testing.T) {
+		type tcase struct {
+			s       string
+			want    CurrencyCode
+			wantErr error
+		}
+
+		tests := map[string]tcase{
+			"Upper":   {"EUR", EUR, nil},
+			"Lower":   {"eur", EUR, nil},
+			"TooLong": {"EURO", 0, ErrInvalidStringCode},
+			"Unknown": {"ZZZ", 0, ErrInvalidStringCode},
+		}
+
+		for name, tc := range tests {
+			t.Run(name, func(t *testing.T) {
+				got, err := Parse[CurrencyCode](tc.s)
+				if tc.wantErr != nil {
+					if !errors.Is(err, tc.wantErr) {
+						t.Errorf("Parse() error = %v, wantErr %v", err, tc.wantErr)
+					}
+				} else {
+					if got != tc.want {
+						t.Errorf("Parse() got = %v, want %v", got, tc.want)
+					}
+				}
+			})
+		}
+	})
+}
+
+func TestMustParse(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		if got := MustParse[CurrencyCode]("usd"); got != USD {
+			t.Errorf("MustParse() got = %v, want %v", got, USD)
+		}
+	})
+
+	t.Run("Panic", func(t *testing.T) {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Errorf("MustParse() should panic")
+			}
+		}()
+
+		MustParse[CountryCode]("ZZ")
+	})
+}
+
+func TestList(t *testing.T) {
+	t.Run("CountryCode", func(t *testing.T) {
+		got := List[CountryCode]()
+
+		if !sort.SliceIsSorted(got, func(i, j int) bool { return got[i].String() < got[j].String() }) {
+			t.Errorf("List() should return sorted slice")
+		}
+
+		if len(got) != len(countryCodesDetails) {
+			t.Errorf("List() should have len == %d", len(countryCodesDetails))
+		}
+	})
+
+	t.Run("CurrencyCode", func(t *testing.T) {
+		got := List[CurrencyCode]()
+
+		if !sort.SliceIsSorted(got, func(i, j int) bool { return got[i].String() < got[j].String() }) {
+			t.Errorf("List() should return sorted slice")
+		}
+
+		if len(got) != len(currencyCodesDetails) {
+			t.Errorf("List() should have len == %d", len(currencyCodesDetails))
+		}
+	})
+}
+
+// validate is an example of a generic validation layer built on Code.
+func validate[T Code](codes ...T) bool {
+	for _, c := range codes {
+		if !c.IsValid() {
+			return false
+		}
+	}
+
+	return true
+}
+
+func TestCode_Generic(t *testing.T) {
+	if !validate(AD, ZW) || !validate(USD, EUR) {
+		t.Errorf("validate() should accept valid codes")
+	}
+
+	if validate(AD, 250) || validate(UnknownCurrency) {
+		t.Errorf("validate() should reject invalid codes")
+	}
+}
@@ -1,7 +1,7 @@
 package isocodes
 
 import (
-	"sort"
+	"database/sql/driver"
 	"strings"
 )
 
@@ -9,47 +9,91 @@ import (
 type CountryCode byte
 
 // String returns an Alpha2 string representation of the code.
-func (c CountryCode) String() string { return countryCodesDetails[c].Alpha2 }
+func (c CountryCode) String() string { return c.details().Alpha2 }
 
 // Alpha3 returns an Alpha3 string representation of the code.
-func (c CountryCode) Alpha3() string { return countryCodesDetails[c].Alpha3 }
+func (c CountryCode) Alpha3() string { return c.details().Alpha3 }
 
 // Name returns a country name related to CountryCode.
-func (c CountryCode) Name() string { return countryCodesDetails[c].Name }
+func (c CountryCode) Name() string { return c.details().Name }
 
 // Number returns a country number related to the CountryCode.
-func (c CountryCode) Number() string { return countryCodesDetails[c].Number }
+func (c CountryCode) Number() string { return c.details().Number }
 
 // Flag returns an emoji flag for the country code.
-func (c CountryCode) Flag() string { return countryCodesDetails[c].Flag }
+func (c CountryCode) Flag() string { return c.details().Flag }
 
-func (c *CountryCode) UnmarshalJSON(b []byte) error {
-	if len(b) == 0 {
-		return ErrUnmarshalJSON
-	}
+// IsZero reports whether the code is the zero value, which is UnknownCountry.
+func (c CountryCode) IsZero() bool { return c == UnknownCountry }
 
-	code, ok := stringToCountryCode[string(b)]
-	if !ok {
-		return ErrUnmarshalJSON
+// IsValid reports whether the code is a known ISO 3166-1 country code.
+// The zero value and out of range values are not valid.
+func (c CountryCode) IsValid() bool {
+	if _, ok := countryCodesDetails[c]; ok {
+		return true
 	}
 
-	*c = code
+	_, ok := countryRegistry.get(c)
 
-	return nil
+	return ok
 }
 
-func (c CountryCode) MarshalJSON() ([]byte, error) {
-	code := c.String()
-	if code == "" {
-		return nil, ErrMarshalJSON
+// UnmarshalJSON implements json.Unmarshaler.
+// JSON null and an empty string are unmarshalled into UnknownCountry.
+func (c *CountryCode) UnmarshalJSON(b []byte) error { return unmarshalJSON(c, b) }
+
+// MarshalJSON implements json.Marshaler.
+// UnknownCountry is marshalled as JSON null. Invalid codes cause
+// ErrMarshalJSON unless the MarshalLenient policy is set.
+func (c CountryCode) MarshalJSON() ([]byte, error) { return marshalJSON(c) }
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It accepts both quoted and bare codes,
+// an empty text is unmarshalled into UnknownCountry.
+func (c *CountryCode) UnmarshalText(b []byte) error {
+	return parseCode(c, unquote(b), ErrUnmarshalJSON)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// The code is quoted like in JSON, UnknownCountry is marshalled as an empty text.
+// Invalid codes cause ErrMarshalText unless the MarshalLenient policy is set.
+func (c CountryCode) MarshalText() ([]byte, error) { return marshalText(c) }
+
+// Scan implements sql.Scanner.
+// SQL NULL and an empty string are scanned into UnknownCountry.
+func (c *CountryCode) Scan(src any) error { return scanSQL(c, src) }
+
+// Value implements driver.Valuer.
+// UnknownCountry is stored as SQL NULL.
+func (c CountryCode) Value() (driver.Value, error) { return valueSQL(c) }
+
+func (CountryCode) format() CodeFormat { return FormatAlpha2 }
+
+func (CountryCode) lookup(s string) (uint8, bool) {
+	code, ok := stringToCountryCode[s]
+	if !ok {
+		code, ok = countryRegistry.lookup(s)
 	}
 
-	return []byte(`"` + code + `"`), nil
+	return uint8(code), ok
 }
 
-func (c *CountryCode) UnmarshalText(b []byte) error { return c.UnmarshalJSON(b) }
+func (CountryCode) reserved(s string) (uint8, bool) {
+	code, ok := reservedCountryCodes[s]
 
-func (c CountryCode) MarshalText() ([]byte, error) { return c.MarshalJSON() }
+	return uint8(code), ok
+}
+
+// details returns details of the code, including registered custom codes.
+func (c CountryCode) details() CountryCodeDetails {
+	if d, ok := countryCodesDetails[c]; ok {
+		return d
+	}
+
+	d, _ := countryRegistry.get(c)
+
+	return d
+}
 
 // CountryCodeDetails represents detailed information related to country code.
 type CountryCodeDetails struct {
@@ -62,33 +106,48 @@ type CountryCodeDetails struct {
 
 // StringToCountryCode takes string representation of ISO 3166-1 Alpha2 country
 // code and returns a CountryCode.
-func StringToCountryCode(code string) (CountryCode, error) {
-	if len(code) > 2 {
-		return 0, ErrInvalidStringCode
-	}
+func StringToCountryCode(code string, opts ...ParseOption) (CountryCode, error) {
+	return Parse[CountryCode](code, opts...)
+}
 
-	c, ok := stringToCountryCode[strings.ToUpper(code)]
-	if !ok {
-		return 0, ErrInvalidStringCode
+// parseError returns ParseError for the invalid string
+// representation of CountryCode. When the input is a valid Alpha3,
+// numeric or reserved code, the related Alpha2 code is suggested first.
+func (CountryCode) parseError(input string, err error) *ParseError {
+	upper := strings.ToUpper(input)
+	codes := ListCountryCodes()
+	candidates := make([]string, 0, len(codes))
+	preferred := make([]string, 0, 1)
+
+	if c, ok := reservedCountryCodes[upper]; ok {
+		preferred = append(preferred, c.String())
 	}
 
-	return c, nil
-}
+	for _, c := range codes {
+		d := c.details()
+		candidates = append(candidates, d.Alpha2)
 
-// ListCountryCodes returns a list of CountryCode.
-func ListCountryCodes() []CountryCode {
-	codes := make([]CountryCode, 0, len(stringToCountryCode))
+		if d.Alpha3 == upper || d.Number == upper {
+			preferred = append(preferred, d.Alpha2)
+		}
+	}
 
-	for _, c := range stringToCountryCode {
-		codes = append(codes, c)
+	return &ParseError{
+		Input:       input,
+		Type:        CodeTypeCountry,
+		Format:      FormatAlpha2,
+		Suggestions: suggest(input, candidates, preferred...),
+		Err:         err,
 	}
+}
 
-	sort.Slice(codes, func(i, j int) bool {
-		return codes[i].String() < codes[j].String()
-	})
+// ListCountryCodes returns a list of CountryCode sorted by string representation.
+func ListCountryCodes() []CountryCode { return List[CountryCode]() }
 
-	return codes
-}
+// UnknownCountry represents the zero value of CountryCode.
+// It is not a valid country code and is used to indicate an absent
+// or unknown country. All accessors of UnknownCountry return empty strings.
+const UnknownCountry CountryCode = 0
 
 // Enumeration of ISO 3166-1 country codes.
 const (
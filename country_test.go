@@ -1,6 +1,8 @@
 package isocodes
 
 import (
+	"database/sql/driver"
+	"errors"
 	"reflect"
 	"sort"
 	"testing"
@@ -548,7 +550,8 @@ func TestCountryCode_MarshalJSON(t *testing.T) {
 	}
 
 	tests := map[string]tcase{
-		"ErrMarshalJSON": {0, nil, ErrMarshalJSON},
+		"ErrMarshalJSON": {250, nil, ErrMarshalJSON},
+		"Zero":           {0, []byte("null"), nil},
 		"AD":             {AD, []byte(`"AD"`), nil},
 		"AE":             {AE, []byte(`"AE"`), nil},
 		"AF":             {AF, []byte(`"AF"`), nil},
@@ -804,7 +807,7 @@ func TestCountryCode_MarshalJSON(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			got, err := tc.code.MarshalJSON()
 			if tc.wantErr != nil {
-				if !reflect.DeepEqual(tc.wantErr, err) {
+				if !errors.Is(err, tc.wantErr) {
 					t.Errorf("MarshalJSON() error = %v, wantErr %v", err, tc.wantErr)
 				}
 			} else {
@@ -2158,7 +2161,7 @@ func TestCountryCode_UnmarshalJSON(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			err := tc.code.UnmarshalJSON(tc.b)
 			if tc.wantErr != nil {
-				if !reflect.DeepEqual(tc.wantErr, err) {
+				if !errors.Is(err, tc.wantErr) {
 					t.Errorf("UnmarshalJSON() error = %v, wantErr %v", err, tc.wantErr)
 				}
 			} else {
@@ -2433,7 +2436,7 @@ func TestCountryCode_UnmarshalText(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			err := tc.code.UnmarshalText([]byte(tc.want.String()))
 			if tc.wantErr != nil {
-				if !reflect.DeepEqual(tc.wantErr, err) {
+				if !errors.Is(err, tc.wantErr) {
 					t.Errorf("UnmarshalText() error = %v, wantErr %v", err, tc.wantErr)
 				}
 			} else {
@@ -2712,7 +2715,7 @@ func TestStringToCountryCode(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			got, err := StringToCountryCode(tc.strCode)
 			if tc.wantErr != nil {
-				if !reflect.DeepEqual(tc.wantErr, err) {
+				if !errors.Is(err, tc.wantErr) {
 					t.Errorf("StringToCountryCode() error = %v, wantErr %v", err, tc.wantErr)
 				}
 			} else {
@@ -2739,3 +2742,109 @@ func TestListCountryCodes(t *testing.T) {
 		}
 	})
 }
+
+func TestCountryCode_IsZero(t *testing.T) {
+	type tcase struct {
+		code CountryCode
+		want bool
+	}
+
+	tests := map[string]tcase{
+		"Zero":    {UnknownCountry, true},
+		"AD":      {AD, false},
+		"Invalid": {250, false},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := tc.code.IsZero(); got != tc.want {
+				t.Errorf("IsZero() got = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCountryCode_IsValid(t *testing.T) {
+	type tcase struct {
+		code CountryCode
+		want bool
+	}
+
+	tests := map[string]tcase{
+		"Zero":    {UnknownCountry, false},
+		"AD":      {AD, true},
+		"ZW":      {ZW, true},
+		"Invalid": {250, false},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := tc.code.IsValid(); got != tc.want {
+				t.Errorf("IsValid() got = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCountryCode_Scan(t *testing.T) {
+	type tcase struct {
+		src     any
+		want    CountryCode
+		wantErr error
+	}
+
+	tests := map[string]tcase{
+		"Nil":        {nil, UnknownCountry, nil},
+		"Empty":      {"", UnknownCountry, nil},
+		"String":     {"AD", AD, nil},
+		"Bytes":      {[]byte("ZW"), ZW, nil},
+		"ErrScanSQL": {"ZZ", UnknownCountry, ErrScanSQL},
+		"ErrType":    {42, UnknownCountry, ErrScanSQL},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var code CountryCode
+
+			err := code.Scan(tc.src)
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Errorf("Scan() error = %v, wantErr %v", err, tc.wantErr)
+				}
+			} else {
+				if code != tc.want {
+					t.Errorf("Scan() got = %v, want %v", code, tc.want)
+				}
+			}
+		})
+	}
+}
+
+func TestCountryCode_Value(t *testing.T) {
+	type tcase struct {
+		code    CountryCode
+		want    driver.Value
+		wantErr error
+	}
+
+	tests := map[string]tcase{
+		"Zero":        {UnknownCountry, nil, nil},
+		"AD":          {AD, "AD", nil},
+		"ErrValueSQL": {250, nil, ErrValueSQL},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.code.Value()
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Errorf("Value() error = %v, wantErr %v", err, tc.wantErr)
+				}
+			} else {
+				if !reflect.DeepEqual(got, tc.want) {
+					t.Errorf("Value() got = %v, want %v", got, tc.want)
+				}
+			}
+		})
+	}
+}
@@ -1,7 +1,7 @@
 package isocodes
 
 import (
-	"sort"
+	"database/sql/driver"
 	"strings"
 )
 
@@ -9,44 +9,84 @@ import (
 type CurrencyCode byte
 
 // String returns string representation of the code.
-func (c CurrencyCode) String() string { return currencyCodesDetails[c].Code }
+func (c CurrencyCode) String() string { return c.details().Code }
 
 // Name returns a currency name.
-func (c CurrencyCode) Name() string { return currencyCodesDetails[c].Name }
+func (c CurrencyCode) Name() string { return c.details().Name }
 
 // Number returns a currency number related to the CurrencyCode.
-func (c CurrencyCode) Number() string { return currencyCodesDetails[c].Number }
+func (c CurrencyCode) Number() string { return c.details().Number }
 
 // Flag returns an emoji flag for the country code.
-func (c CurrencyCode) Flag() string { return currencyCodesDetails[c].Flag }
+func (c CurrencyCode) Flag() string { return c.details().Flag }
 
-func (c *CurrencyCode) UnmarshalJSON(b []byte) error {
-	if len(b) == 0 {
-		return ErrUnmarshalJSON
-	}
+// IsZero reports whether the code is the zero value, which is UnknownCurrency.
+func (c CurrencyCode) IsZero() bool { return c == UnknownCurrency }
 
-	code, ok := stringToCurrencyCode[string(b)]
-	if !ok {
-		return ErrUnmarshalJSON
+// IsValid reports whether the code is a known ISO 4217 currency code.
+// The zero value and out of range values are not valid.
+func (c CurrencyCode) IsValid() bool {
+	if _, ok := currencyCodesDetails[c]; ok {
+		return true
 	}
 
-	*c = code
+	_, ok := currencyRegistry.get(c)
+
+	return ok
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// JSON null and an empty string are unmarshalled into UnknownCurrency.
+func (c *CurrencyCode) UnmarshalJSON(b []byte) error { return unmarshalJSON(c, b) }
+
+// MarshalJSON implements json.Marshaler.
+// UnknownCurrency is marshalled as JSON null. Invalid codes cause
+// ErrMarshalJSON unless the MarshalLenient policy is set.
+func (c CurrencyCode) MarshalJSON() ([]byte, error) { return marshalJSON(c) }
 
-	return nil
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It accepts both quoted and bare codes,
+// an empty text is unmarshalled into UnknownCurrency.
+func (c *CurrencyCode) UnmarshalText(b []byte) error {
+	return parseCode(c, unquote(b), ErrUnmarshalJSON)
 }
 
-func (c CurrencyCode) MarshalJSON() ([]byte, error) {
-	code := c.String()
-	if code == "" {
-		return nil, ErrMarshalJSON
+// MarshalText implements encoding.TextMarshaler.
+// The code is quoted like in JSON, UnknownCurrency is marshalled as an empty text.
+// Invalid codes cause ErrMarshalText unless the MarshalLenient policy is set.
+func (c CurrencyCode) MarshalText() ([]byte, error) { return marshalText(c) }
+
+// Scan implements sql.Scanner.
+// SQL NULL and an empty string are scanned into UnknownCurrency.
+func (c *CurrencyCode) Scan(src any) error { return scanSQL(c, src) }
+
+// Value implements driver.Valuer.
+// UnknownCurrency is stored as SQL NULL.
+func (c CurrencyCode) Value() (driver.Value, error) { return valueSQL(c) }
+
+func (CurrencyCode) format() CodeFormat { return FormatAlpha3 }
+
+func (CurrencyCode) lookup(s string) (uint8, bool) {
+	code, ok := stringToCurrencyCode[s]
+	if !ok {
+		code, ok = currencyRegistry.lookup(s)
 	}
 
-	return []byte(`"` + code + `"`), nil
+	return uint8(code), ok
 }
 
-func (c *CurrencyCode) UnmarshalText(b []byte) error { return c.UnmarshalJSON(b) }
+func (CurrencyCode) reserved(string) (uint8, bool) { return 0, false }
 
-func (c CurrencyCode) MarshalText() ([]byte, error) { return c.MarshalJSON() }
+// details returns details of the code, including registered custom codes.
+func (c CurrencyCode) details() CurrencyCodeDetails {
+	if d, ok := currencyCodesDetails[c]; ok {
+		return d
+	}
+
+	d, _ := currencyRegistry.get(c)
+
+	return d
+}
 
 // CurrencyCodeDetails represents detailed information related to the currency code.
 type CurrencyCodeDetails struct {
@@ -59,34 +99,45 @@ type CurrencyCodeDetails struct {
 
 // StringToCurrencyCode takes string representation of an ISO currency
 // code and returns a CurrencyCode.
-func StringToCurrencyCode(code string) (CurrencyCode, error) {
-	if len(code) > 3 {
-		return 0, ErrInvalidStringCode
-	}
+func StringToCurrencyCode(code string, opts ...ParseOption) (CurrencyCode, error) {
+	return Parse[CurrencyCode](code, opts...)
+}
 
-	c, ok := stringToCurrencyCode[strings.ToUpper(code)]
-	if !ok {
-		return 0, ErrInvalidStringCode
-	}
+// parseError returns ParseError for the invalid string
+// representation of CurrencyCode. When the input is a valid numeric
+// code, the related alphabetic code is suggested first.
+func (CurrencyCode) parseError(input string, err error) *ParseError {
+	codes := ListCurrencyCodes()
+	candidates := make([]string, 0, len(codes))
+	preferred := make([]string, 0, 1)
 
-	return c, nil
-}
+	for _, c := range codes {
+		d := c.details()
+		candidates = append(candidates, d.Code)
 
-// ListCurrencyCodes returns a list of CurrencyCode.
-func ListCurrencyCodes() []CurrencyCode {
-	codes := make([]CurrencyCode, 0, len(stringToCurrencyCode))
+		if input != "" && d.Number == strings.TrimLeft(input, "0") {
+			preferred = append(preferred, d.Code)
+		}
+	}
 
-	for _, c := range stringToCurrencyCode {
-		codes = append(codes, c)
+	return &ParseError{
+		Input:       input,
+		Type:        CodeTypeCurrency,
+		Format:      FormatAlpha3,
+		Suggestions: suggest(input, candidates, preferred...),
+		Err:         err,
 	}
+}
 
-	sort.Slice(codes, func(i, j int) bool {
-		return codes[i].String() < codes[j].String()
-	})
+// ListCurrencyCodes returns a list of CurrencyCode sorted by string representation.
+func ListCurrencyCodes() []CurrencyCode { return List[CurrencyCode]() }
 
-	return codes
-}
+// UnknownCurrency represents the zero value of CurrencyCode.
+// It is not a valid currency code and is used to indicate an absent
+// or unknown currency. All accessors of UnknownCurrency return empty values.
+const UnknownCurrency CurrencyCode = 0
 
+// Enumeration of ISO 4217 currency codes.
 const (
 	// AED represents ISO currency code of the United Arab Emirates dirham.
 	AED CurrencyCode = iota + 1
@@ -1,6 +1,8 @@
 package isocodes
 
 import (
+	"database/sql/driver"
+	"errors"
 	"reflect"
 	"sort"
 	"testing"
@@ -210,7 +212,8 @@ func TestCurrencyCode_MarshalJSON(t *testing.T) {
 	}
 
 	tests := map[string]tcase{
-		"ErrMarshalJSON": {0, nil, ErrMarshalJSON},
+		"ErrMarshalJSON": {250, nil, ErrMarshalJSON},
+		"Zero":           {0, []byte("null"), nil},
 		"AED":            {AED, []byte(`"AED"`), nil},
 		"AFN":            {AFN, []byte(`"AFN"`), nil},
 		"ALL":            {ALL, []byte(`"ALL"`), nil},
@@ -395,7 +398,7 @@ func TestCurrencyCode_MarshalJSON(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			got, err := tc.code.MarshalJSON()
 			if tc.wantErr != nil {
-				if !reflect.DeepEqual(tc.wantErr, err) {
+				if !errors.Is(err, tc.wantErr) {
 					t.Errorf("MarshalJSON() error = %v, wantErr %v", err, tc.wantErr)
 				}
 			} else {
@@ -1205,7 +1208,7 @@ func TestCurrencyCode_UnmarshalJSON(t *testing.T) {
 		wantErr := ErrUnmarshalJSON
 
 		err := code.UnmarshalJSON(nil)
-		if !reflect.DeepEqual(wantErr, err) {
+		if !errors.Is(err, wantErr) {
 			t.Errorf("UnmarshalJSON() error = %v, wantErr %v", err, wantErr)
 		}
 	})
@@ -1215,7 +1218,7 @@ func TestCurrencyCode_UnmarshalJSON(t *testing.T) {
 		wantErr := ErrUnmarshalJSON
 
 		err := code.UnmarshalJSON(nil)
-		if !reflect.DeepEqual(wantErr, err) {
+		if !errors.Is(err, wantErr) {
 			t.Errorf("UnmarshalJSON() error = %v, wantErr %v", err, wantErr)
 		}
 	})
@@ -1414,7 +1417,7 @@ func TestCurrencyCode_UnmarshalJSON(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			err := tc.code.UnmarshalJSON(tc.b)
 			if tc.wantErr != nil {
-				if !reflect.DeepEqual(tc.wantErr, err) {
+				if !errors.Is(err, tc.wantErr) {
 					t.Errorf("UnmarshalJSON() error = %v, wantErr %v", err, tc.wantErr)
 				}
 			} else {
@@ -1618,7 +1621,7 @@ func TestCurrencyCode_UnmarshalText(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			err := tc.code.UnmarshalText([]byte(tc.want.String()))
 			if tc.wantErr != nil {
-				if !reflect.DeepEqual(tc.wantErr, err) {
+				if !errors.Is(err, tc.wantErr) {
 					t.Errorf("UnmarshalText() error = %v, wantErr %v", err, tc.wantErr)
 				}
 			} else {
@@ -1826,7 +1829,7 @@ func TestStringToCurrencyCode(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			got, err := StringToCurrencyCode(tc.strCode)
 			if tc.wantErr != nil {
-				if !reflect.DeepEqual(tc.wantErr, err) {
+				if !errors.Is(err, tc.wantErr) {
 					t.Errorf("StringToCurrencyCode() error = %v, wantErr %v", err, tc.wantErr)
 				}
 			} else {
@@ -1853,3 +1856,109 @@ func TestListCurrencyCodes(t *testing.T) {
 		}
 	})
 }
+
+func TestCurrencyCode_IsZero(t *testing.T) {
+	type tcase struct {
+		code CurrencyCode
+		want bool
+	}
+
+	tests := map[string]tcase{
+		"Zero":    {UnknownCurrency, true},
+		"AED":     {AED, false},
+		"Invalid": {250, false},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := tc.code.IsZero(); got != tc.want {
+				t.Errorf("IsZero() got = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCurrencyCode_IsValid(t *testing.T) {
+	type tcase struct {
+		code CurrencyCode
+		want bool
+	}
+
+	tests := map[string]tcase{
+		"Zero":    {UnknownCurrency, false},
+		"AED":     {AED, true},
+		"ZMW":     {ZMW, true},
+		"Invalid": {250, false},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := tc.code.IsValid(); got != tc.want {
+				t.Errorf("IsValid() got = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCurrencyCode_Scan(t *testing.T) {
+	type tcase struct {
+		src     any
+		want    CurrencyCode
+		wantErr error
+	}
+
+	tests := map[string]tcase{
+		"Nil":        {nil, UnknownCurrency, nil},
+		"Empty":      {"", UnknownCurrency, nil},
+		"String":     {"AED", AED, nil},
+		"Bytes":      {[]byte("ZMW"), ZMW, nil},
+		"ErrScanSQL": {"ZZZ", UnknownCurrency, ErrScanSQL},
+		"ErrType":    {42, UnknownCurrency, ErrScanSQL},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var code CurrencyCode
+
+			err := code.Scan(tc.src)
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Errorf("Scan() error = %v, wantErr %v", err, tc.wantErr)
+				}
+			} else {
+				if code != tc.want {
+					t.Errorf("Scan() got = %v, want %v", code, tc.want)
+				}
+			}
+		})
+	}
+}
+
+func TestCurrencyCode_Value(t *testing.T) {
+	type tcase struct {
+		code    CurrencyCode
+		want    driver.Value
+		wantErr error
+	}
+
+	tests := map[string]tcase{
+		"Zero":        {UnknownCurrency, nil, nil},
+		"AED":         {AED, "AED", nil},
+		"ErrValueSQL": {250, nil, ErrValueSQL},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.code.Value()
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Errorf("Value() error = %v, wantErr %v", err, tc.wantErr)
+				}
+			} else {
+				if !reflect.DeepEqual(got, tc.want) {
+					t.Errorf("Value() got = %v, want %v", got, tc.want)
+				}
+			}
+		})
+	}
+}
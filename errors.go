@@ -1,5 +1,11 @@
 package isocodes
 
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
 const (
 	// ErrMarshalJSON - indicates an error in the process
 	// of marshalling code to json.
@@ -9,12 +15,398 @@ const (
 	// of unmarshalling code to json.
 	ErrUnmarshalJSON Error = "failed to unmarshal json"
 
+	// ErrMarshalText - indicates an error in the process
+	// of marshalling code to text.
+	ErrMarshalText Error = "failed to marshal text"
+
 	// ErrInvalidStringCode - indicates an error in the process
 	// of converting string representation to code type.
 	ErrInvalidStringCode Error = "invalid string representation of the code"
+
+	// ErrScanSQL - indicates an error in the process
+	// of scanning sql value to code.
+	ErrScanSQL Error = "failed to scan sql value"
+
+	// ErrValueSQL - indicates an error in the process
+	// of converting code to sql value.
+	ErrValueSQL Error = "failed to convert code to sql value"
+
+	// ErrInvalidPhoneNumber - indicates an error in the process
+	// of parsing phone number.
+	ErrInvalidPhoneNumber Error = "invalid phone number"
+
+	// ErrUnknownCallingCode - indicates that phone number
+	// starts with an unknown calling code.
+	ErrUnknownCallingCode Error = "unknown calling code"
+
+	// ErrPhoneNumberLength - indicates that the length of phone number
+	// doesn't fit the numbering plan of the country.
+	ErrPhoneNumberLength Error = "invalid phone number length"
+
+	// ErrPhoneNumberCountry - indicates that phone number
+	// belongs to another country.
+	ErrPhoneNumberCountry Error = "phone number belongs to another country"
+
+	// ErrUnknownTLD - indicates that the domain
+	// is not a known country code top-level domain.
+	ErrUnknownTLD Error = "unknown top-level domain"
+
+	// ErrInvalidIBAN - indicates that IBAN doesn't match
+	// the length or the BBAN structure of the country.
+	ErrInvalidIBAN Error = "invalid IBAN"
+
+	// ErrInvalidIBANChecksum - indicates that IBAN check digits
+	// don't match the mod-97 checksum.
+	ErrInvalidIBANChecksum Error = "invalid IBAN check digits"
+
+	// ErrUnsupportedIBANCountry - indicates that the country
+	// doesn't use IBAN.
+	ErrUnsupportedIBANCountry Error = "country doesn't use IBAN"
+
+	// ErrInvalidBIC - indicates that BIC doesn't match
+	// the ISO 9362 structure or has unknown country code.
+	ErrInvalidBIC Error = "invalid BIC"
+
+	// ErrInvalidISIN - indicates that ISIN doesn't match
+	// the ISO 6166 structure or has unknown prefix.
+	ErrInvalidISIN Error = "invalid ISIN"
+
+	// ErrInvalidISINChecksum - indicates that ISIN check digit
+	// doesn't match the Luhn checksum.
+	ErrInvalidISINChecksum Error = "invalid ISIN check digit"
+
+	// ErrInvalidLEI - indicates that LEI doesn't match the ISO 17442 structure.
+	ErrInvalidLEI Error = "invalid LEI"
+
+	// ErrInvalidLEIChecksum - indicates that LEI check digits
+	// don't match the mod-97 checksum.
+	ErrInvalidLEIChecksum Error = "invalid LEI check digits"
+
+	// ErrUnknownMIC - indicates that MIC isn't known to the ISO 10383 registry.
+	ErrUnknownMIC Error = "unknown MIC"
+
+	// ErrInvalidVATNumber - indicates that VAT identification number doesn't
+	// match the format or the check digits of the member state.
+	ErrInvalidVATNumber Error = "invalid VAT number"
+
+	// ErrUnsupportedVATPrefix - indicates that VAT identification
+	// number prefix isn't a prefix of the EU member state.
+	ErrUnsupportedVATPrefix Error = "unsupported VAT number prefix"
+
+	// ErrVATCheck - indicates that the online VAT number check failed.
+	ErrVATCheck Error = "VAT number check failed"
+
+	// ErrInvalidPostalCode - indicates that postal code
+	// doesn't match the format of the country.
+	ErrInvalidPostalCode Error = "invalid postal code"
+
+	// ErrMissingAddressFields - indicates that the address
+	// lacks fields required by the country address format.
+	ErrMissingAddressFields Error = "missing required address fields"
+
+	// ErrInvalidSetExpression - indicates that the country set expression can't be parsed.
+	ErrInvalidSetExpression Error = "invalid set expression"
+
+	// ErrUnknownTimeZone - indicates that the time zone
+	// doesn't belong to a country or the country has no time zones.
+	ErrUnknownTimeZone Error = "unknown time zone"
+
+	// ErrInvalidPolicy - indicates that the policy configuration is invalid.
+	ErrInvalidPolicy Error = "invalid policy"
+
+	// ErrNotUserAssigned - indicates an attempt to register
+	// a custom code outside of the ISO user-assigned ranges.
+	ErrNotUserAssigned Error = "code is not in the user-assigned range"
+
+	// ErrInvalidDetails - indicates an attempt to register
+	// a custom code with incomplete or invalid details.
+	ErrInvalidDetails Error = "invalid code details"
+
+	// ErrCodeExists - indicates an attempt to register
+	// a custom code which already exists with different details.
+	ErrCodeExists Error = "code already exists"
+
+	// ErrRegistryFull - indicates that no more custom codes
+	// of the type can be registered.
+	ErrRegistryFull Error = "no more custom codes can be registered"
 )
 
 // Error represents package level errors.
 type Error string
 
 func (e Error) Error() string { return string(e) }
+
+// CodeType represents a type of the code which is the target of parsing.
+type CodeType string
+
+const (
+	// CodeTypeCountry represents ISO 3166-1 country code type.
+	CodeTypeCountry CodeType = "country"
+
+	// CodeTypeCurrency represents ISO 4217 currency code type.
+	CodeTypeCurrency CodeType = "currency"
+
+	// CodeTypePhoneNumber represents ITU-T E.164 phone number type.
+	CodeTypePhoneNumber CodeType = "phone number"
+
+	// CodeTypeIBAN represents ISO 13616 International Bank Account Number type.
+	CodeTypeIBAN CodeType = "IBAN"
+
+	// CodeTypeBIC represents ISO 9362 Business Identifier Code type.
+	CodeTypeBIC CodeType = "BIC"
+
+	// CodeTypeISIN represents ISO 6166 International Securities Identification Number type.
+	CodeTypeISIN CodeType = "ISIN"
+
+	// CodeTypeLEI represents ISO 17442 Legal Entity Identifier type.
+	CodeTypeLEI CodeType = "LEI"
+
+	// CodeTypeMIC represents ISO 10383 Market Identifier Code type.
+	CodeTypeMIC CodeType = "MIC"
+
+	// CodeTypeVATNumber represents EU VAT identification number type.
+	CodeTypeVATNumber CodeType = "VAT number"
+
+	// CodeTypePostalCode represents postal code type.
+	CodeTypePostalCode CodeType = "postal code"
+)
+
+// noun returns the name of the type used in error messages.
+func (t CodeType) noun() string {
+	if t == CodeTypeCountry || t == CodeTypeCurrency {
+		return string(t) + " code"
+	}
+
+	return string(t)
+}
+
+// CodeFormat represents a format of the string representation of the code.
+type CodeFormat string
+
+const (
+	// FormatAlpha2 represents two letters code format, e.g. ISO 3166-1 Alpha-2.
+	FormatAlpha2 CodeFormat = "alpha-2"
+
+	// FormatAlpha3 represents three letters code format, e.g. ISO 3166-1 Alpha-3 or ISO 4217.
+	FormatAlpha3 CodeFormat = "alpha-3"
+
+	// FormatNumeric represents three digits code format, e.g. ISO 3166-1 numeric.
+	FormatNumeric CodeFormat = "numeric"
+
+	// FormatTLD represents country code top-level domain format, e.g. ".de".
+	FormatTLD CodeFormat = "ccTLD"
+
+	// FormatISO13616 represents ISO 13616 IBAN format.
+	FormatISO13616 CodeFormat = "ISO 13616"
+
+	// FormatISO9362 represents ISO 9362 BIC format.
+	FormatISO9362 CodeFormat = "ISO 9362"
+
+	// FormatISO6166 represents ISO 6166 ISIN format.
+	FormatISO6166 CodeFormat = "ISO 6166"
+
+	// FormatISO17442 represents ISO 17442 LEI format.
+	FormatISO17442 CodeFormat = "ISO 17442"
+
+	// FormatISO10383 represents ISO 10383 MIC format.
+	FormatISO10383 CodeFormat = "ISO 10383"
+
+	// FormatEUVAT represents EU VAT identification number format.
+	FormatEUVAT CodeFormat = "EU VAT"
+
+	// FormatIANATimeZone represents IANA time zone database name format, e.g. "Europe/Kyiv".
+	FormatIANATimeZone CodeFormat = "IANA time zone"
+
+	// FormatE164 represents ITU-T E.164 international phone number format.
+	FormatE164 CodeFormat = "E.164"
+
+	// FormatNational represents format defined by the country, e.g. the postal code format.
+	// The country is held by ParseError.Country.
+	FormatNational CodeFormat = "national"
+)
+
+// SuggestionsLimit holds the maximum number of suggestions in ParseError.
+const SuggestionsLimit = 3
+
+// ParseError represents an error in the process of parsing
+// the string representation of the code. It carries enough context
+// to be rendered as an API error response.
+//
+// ParseError wraps one of the package sentinel errors,
+// so errors.Is(err, ErrInvalidStringCode) works as before.
+type ParseError struct {
+	// Input holds the string representation which failed to parse.
+	Input string `json:"input"`
+
+	// Type holds the type of the code the input was parsed to.
+	Type CodeType `json:"type"`
+
+	// Format holds the attempted format of the code.
+	Format CodeFormat `json:"format"`
+
+	// Country holds the country of the FormatNational format.
+	Country CountryCode `json:"country,omitempty"`
+
+	// Suggestions holds up to SuggestionsLimit nearest valid codes.
+	Suggestions []string `json:"suggestions,omitempty"`
+
+	// Err holds the wrapped sentinel error.
+	Err error `json:"-"`
+}
+
+func (e *ParseError) Error() string {
+	var b strings.Builder
+
+	if e.Err != nil {
+		b.WriteString(e.Err.Error())
+		b.WriteString(": ")
+	}
+
+	b.WriteString(strconv.Quote(e.Input))
+	b.WriteString(" is not a valid ")
+	b.WriteString(e.Type.noun())
+	b.WriteString(" in ")
+	b.WriteString(string(e.Format))
+	b.WriteString(" format")
+
+	if e.Country.IsValid() {
+		b.WriteString(" of ")
+		b.WriteString(e.Country.String())
+	}
+
+	if len(e.Suggestions) > 0 {
+		b.WriteString(", did you mean ")
+		b.WriteString(strings.Join(e.Suggestions, ", "))
+		b.WriteString("?")
+	}
+
+	return b.String()
+}
+
+func (e *ParseError) Unwrap() error { return e.Err }
+
+// suggestionsInputLimit holds the maximum length of the input for which
+// suggestions are computed. Codes are at most 3 characters long and can't be
+// within half of the length of longer inputs, so those are skipped without
+// any work, which keeps parsing of untrusted input cheap.
+const suggestionsInputLimit = 8
+
+// suggest returns up to SuggestionsLimit candidates nearest to the input
+// by Damerau-Levenshtein distance. Candidates which are farther than half
+// of the input length are not considered as suggestions. The preferred
+// candidates are placed first regardless of their distance.
+func suggest(input string, candidates []string, preferred ...string) []string {
+	if len(input) > suggestionsInputLimit && len(preferred) == 0 {
+		return nil
+	}
+
+	input = strings.ToUpper(input)
+	maxDistance := (len(input) + 1) / 2
+
+	type scored struct {
+		code     string
+		distance int
+	}
+
+	scoredCandidates := make([]scored, 0, len(candidates))
+
+	for _, c := range candidates {
+		// The distance is at least the difference of lengths.
+		if len(input) > suggestionsInputLimit || absInt(len(input)-len(c)) > maxDistance {
+			continue
+		}
+
+		if d := editDistance(input, c); d <= maxDistance {
+			scoredCandidates = append(scoredCandidates, scored{code: c, distance: d})
+		}
+	}
+
+	sort.Slice(scoredCandidates, func(i, j int) bool {
+		if scoredCandidates[i].distance != scoredCandidates[j].distance {
+			return scoredCandidates[i].distance < scoredCandidates[j].distance
+		}
+
+		return scoredCandidates[i].code < scoredCandidates[j].code
+	})
+
+	suggestions := make([]string, 0, SuggestionsLimit)
+	seen := make(map[string]struct{}, SuggestionsLimit)
+
+	add := func(code string) {
+		if _, ok := seen[code]; ok || len(suggestions) == SuggestionsLimit {
+			return
+		}
+
+		seen[code] = struct{}{}
+		suggestions = append(suggestions, code)
+	}
+
+	for _, p := range preferred {
+		add(p)
+	}
+
+	for _, c := range scoredCandidates {
+		add(c.code)
+	}
+
+	if len(suggestions) == 0 {
+		return nil
+	}
+
+	return suggestions
+}
+
+// editDistance returns the optimal string alignment distance between a and b,
+// which is Levenshtein distance extended with transpositions of adjacent characters.
+func editDistance(a, b string) int {
+	d := make([][]int, len(a)+1)
+	for i := range d {
+		d[i] = make([]int, len(b)+1)
+		d[i][0] = i
+	}
+
+	for j := range d[0] {
+		d[0][j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+
+			d[i][j] = minInt(minInt(d[i-1][j]+1, d[i][j-1]+1), d[i-1][j-1]+cost)
+
+			if i > 1 && j > 1 && a[i-1] == b[j-2] && a[i-2] == b[j-1] {
+				d[i][j] = minInt(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+
+	return d[len(a)][len(b)]
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+
+	return b
+}
+
+func absInt(a int) int {
+	if a < 0 {
+		return -a
+	}
+
+	return a
+}
@@ -1,6 +1,9 @@
 package isocodes
 
 import (
+	"errors"
+	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -13,6 +16,7 @@ func TestError_Error(t *testing.T) {
 	tests := map[string]tcase{
 		"ErrMarshalJSON":       {err: ErrMarshalJSON, want: "failed to marshal json"},
 		"ErrUnmarshalJSON":     {err: ErrUnmarshalJSON, want: "failed to unmarshal json"},
+		"ErrMarshalText":       {err: ErrMarshalText, want: "failed to marshal text"},
 		"ErrInvalidStringCode": {err: ErrInvalidStringCode, want: "invalid string representation of the code"},
 		"Custom":               {err: Error("test error"), want: "test error"},
 	}
@@ -25,3 +29,138 @@ func TestError_Error(t *testing.T) {
 		})
 	}
 }
+
+func TestParseError(t *testing.T) {
+	type tcase struct {
+		parse   func() error
+		want    *ParseError
+		wantMsg string
+	}
+
+	tests := map[string]tcase{
+		"Country_Typo": {
+			parse: func() error { _, err := StringToCountryCode("DX"); return err },
+			want: &ParseError{
+				Input: "DX", Type: CodeTypeCountry, Format: FormatAlpha2,
+				Suggestions: []string{"AX", "CX", "DE"}, Err: ErrInvalidStringCode,
+			},
+			wantMsg: `invalid string representation of the code: "DX" is not a valid country code in alpha-2 format, did you mean AX, CX, DE?`,
+		},
+		"Country_Alpha3": {
+			parse: func() error { _, err := StringToCountryCode("deu"); return err },
+			want: &ParseError{
+				Input: "deu", Type: CodeTypeCountry, Format: FormatAlpha2,
+				Suggestions: []string{"DE", "AE", "AU"}, Err: ErrInvalidStringCode,
+			},
+		},
+		"Country_Numeric": {
+			parse: func() error { _, err := StringToCountryCode("826"); return err },
+			want: &ParseError{
+				Input: "826", Type: CodeTypeCountry, Format: FormatAlpha2,
+				Suggestions: []string{"GB"}, Err: ErrInvalidStringCode,
+			},
+		},
+		"Country_UnmarshalJSON": {
+			parse: func() error { var c CountryCode; return c.UnmarshalJSON([]byte(`"QQ"`)) },
+			want: &ParseError{
+				Input: "QQ", Type: CodeTypeCountry, Format: FormatAlpha2,
+				Suggestions: []string{"AQ", "BQ", "GQ"}, Err: ErrUnmarshalJSON,
+			},
+		},
+		"Currency_Transposition": {
+			parse: func() error { _, err := StringToCurrencyCode("UDS"); return err },
+			want: &ParseError{
+				Input: "UDS", Type: CodeTypeCurrency, Format: FormatAlpha3,
+				Suggestions: []string{"USD", "USS", "UZS"}, Err: ErrInvalidStringCode,
+			},
+		},
+		"Currency_Numeric": {
+			parse: func() error { _, err := StringToCurrencyCode("978"); return err },
+			want: &ParseError{
+				Input: "978", Type: CodeTypeCurrency, Format: FormatAlpha3,
+				Suggestions: []string{"EUR"}, Err: ErrInvalidStringCode,
+			},
+			wantMsg: `invalid string representation of the code: "978" is not a valid currency code in alpha-3 format, did you mean EUR?`,
+		},
+		"Currency_Scan": {
+			parse: func() error { var c CurrencyCode; return c.Scan("ZZZZ") },
+			want: &ParseError{
+				Input: "ZZZZ", Type: CodeTypeCurrency, Format: FormatAlpha3, Err: ErrScanSQL,
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.parse()
+
+			var got *ParseError
+			if !errors.As(err, &got) {
+				t.Fatalf("error = %v, want *ParseError", err)
+			}
+
+			if !errors.Is(err, tc.want.Err) {
+				t.Errorf("errors.Is(%v, %v) = false, want true", err, tc.want.Err)
+			}
+
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("ParseError got = %#v, want %#v", got, tc.want)
+			}
+
+			if tc.wantMsg != "" && got.Error() != tc.wantMsg {
+				t.Errorf("Error() got = %v, want %v", got.Error(), tc.wantMsg)
+			}
+		})
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	type tcase struct {
+		a, b string
+		want int
+	}
+
+	tests := map[string]tcase{
+		"Equal":         {"USD", "USD", 0},
+		"Substitution":  {"USD", "UST", 1},
+		"Transposition": {"UDS", "USD", 1},
+		"Insertion":     {"DE", "DEU", 1},
+		"Empty":         {"", "GB", 2},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := editDistance(tc.a, tc.b); got != tc.want {
+				t.Errorf("editDistance() got = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestSuggest_LongInput(t *testing.T) {
+	long := strings.Repeat("D", 20000)
+
+	t.Run("Parse", func(t *testing.T) {
+		_, err := StringToCountryCode(long)
+
+		var got *ParseError
+		if !errors.As(err, &got) {
+			t.Fatalf("error = %v, want *ParseError", err)
+		}
+
+		if got.Suggestions != nil {
+			t.Errorf("Suggestions got = %v, want nil", got.Suggestions)
+		}
+	})
+
+	t.Run("Allocations", func(t *testing.T) {
+		candidates := make([]string, 0, 256)
+		for _, c := range ListCountryCodes() {
+			candidates = append(candidates, c.String())
+		}
+
+		if allocs := testing.AllocsPerRun(10, func() { suggest(long, candidates) }); allocs > 0 {
+			t.Errorf("suggest() allocations got = %v, want 0", allocs)
+		}
+	})
+}
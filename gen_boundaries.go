@@ -0,0 +1,257 @@
+//go:build ignore
+
+// This program generates country boundaries from Natural Earth 1:110m admin-0
+// countries published at https://www.naturalearthdata.com. The data is in the public domain.
+// Polygons are optionally simplified by the Douglas-Peucker algorithm and
+// coordinates are rounded to hundredths of a degree. It is invoked by go generate.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"math"
+	"os"
+	"sort"
+)
+
+// adm0Codes holds country codes of Natural Earth units without ISO 3166-1 code.
+var adm0Codes = map[string]string{
+	"CYN": "CY", // Northern Cyprus.
+	"SOL": "SO", // Somaliland.
+	"KOS": "XK", // Kosovo, the user-assigned code resolves only when registered.
+}
+
+type feature struct {
+	Properties struct {
+		ISOA2  string `json:"iso_a2"`
+		ADM0A3 string `json:"adm0_a3"`
+	} `json:"properties"`
+	Geometry struct {
+		Type        string          `json:"type"`
+		Coordinates json.RawMessage `json:"coordinates"`
+	} `json:"geometry"`
+}
+
+type (
+	ring    [][2]float64
+	polygon []ring
+)
+
+func main() {
+	in := flag.String("in", "data/ne_110m_admin_0_countries.geojson", "path to Natural Earth GeoJSON file")
+	out := flag.String("out", "boundaries_data.go", "path to the generated file")
+	tolerance := flag.Float64("tolerance", 0, "Douglas-Peucker simplification tolerance in degrees")
+	tags := flag.String("tags", "", "build constraint of the generated file")
+	flag.Parse()
+
+	countries, err := readCountries(*in)
+	if err != nil {
+		log.Fatalf("read %s: %v", *in, err)
+	}
+
+	var (
+		b      bytes.Buffer
+		points int
+	)
+
+	b.WriteString("// Code generated by gen_boundaries.go from " + *in + "; DO NOT EDIT.\n\n")
+
+	if *tags != "" {
+		b.WriteString("//go:build " + *tags + "\n\n")
+	}
+
+	b.WriteString("package isocodes\n\n")
+	fmt.Fprintf(&b, "// BoundariesTolerance holds the simplification tolerance of the embedded\n")
+	fmt.Fprintf(&b, "// country boundaries in degrees. Zero means Natural Earth 1:110m boundaries as is.\n")
+	fmt.Fprintf(&b, "const BoundariesTolerance = %v\n\n", *tolerance)
+	b.WriteString("// countryBoundaries holds polygons of countries keyed by Alpha-2 code.\n")
+	b.WriteString("// Each ring is a flat list of longitude and latitude pairs in hundredths of a degree.\n")
+	b.WriteString("var countryBoundaries = []boundary{\n")
+
+	for _, code := range sortedKeys(countries) {
+		for _, p := range countries[code] {
+			fmt.Fprintf(&b, "\t{%q, [][]int32{", code)
+
+			for _, r := range p {
+				r = simplify(r, *tolerance)
+
+				b.WriteString("{")
+
+				for j, pt := range r {
+					if j > 0 {
+						b.WriteString(", ")
+					}
+
+					fmt.Fprintf(&b, "%d, %d", int32(math.Round(pt[0]*100)), int32(math.Round(pt[1]*100)))
+				}
+
+				points += len(r)
+
+				b.WriteString("},")
+			}
+
+			b.WriteString("}},\n")
+		}
+	}
+
+	b.WriteString("}\n")
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatalf("format generated code: %v", err)
+	}
+
+	if err := os.WriteFile(*out, src, 0o644); err != nil {
+		log.Fatalf("write %s: %v", *out, err)
+	}
+
+	log.Printf("%s: %d points", *out, points)
+}
+
+// readCountries reads polygons of features keyed by the country code.
+// French Guiana and Svalbard, which are parts of France and Norway
+// in Natural Earth, are split into territories of their own.
+func readCountries(path string) (map[string][]polygon, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var collection struct {
+		Features []feature `json:"features"`
+	}
+
+	if err := json.Unmarshal(data, &collection); err != nil {
+		return nil, err
+	}
+
+	countries := make(map[string][]polygon)
+
+	for _, f := range collection.Features {
+		code := f.Properties.ISOA2
+		if c, ok := adm0Codes[f.Properties.ADM0A3]; ok {
+			code = c
+		}
+
+		if len(code) != 2 {
+			return nil, fmt.Errorf("unknown code %q of %s", code, f.Properties.ADM0A3)
+		}
+
+		var polygons []polygon
+
+		switch f.Geometry.Type {
+		case "Polygon":
+			var p polygon
+			if err := json.Unmarshal(f.Geometry.Coordinates, &p); err != nil {
+				return nil, err
+			}
+
+			polygons = []polygon{p}
+		case "MultiPolygon":
+			if err := json.Unmarshal(f.Geometry.Coordinates, &polygons); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, fmt.Errorf("unsupported geometry %q of %s", f.Geometry.Type, code)
+		}
+
+		for _, p := range polygons {
+			lon, lat := p[0].mean()
+
+			switch {
+			case code == "FR" && lon < -40:
+				countries["GF"] = append(countries["GF"], p)
+			case code == "NO" && lat > 74:
+				countries["SJ"] = append(countries["SJ"], p)
+			default:
+				countries[code] = append(countries[code], p)
+			}
+		}
+	}
+
+	return countries, nil
+}
+
+// mean returns mean longitude and latitude of the ring points.
+func (r ring) mean() (lon, lat float64) {
+	for _, pt := range r {
+		lon += pt[0]
+		lat += pt[1]
+	}
+
+	return lon / float64(len(r)), lat / float64(len(r))
+}
+
+// simplify simplifies the closed ring by the Douglas-Peucker algorithm.
+// Rings which would degenerate are returned as is.
+func simplify(r ring, tolerance float64) ring {
+	if tolerance <= 0 || len(r) <= 4 {
+		return r
+	}
+
+	keep := make([]bool, len(r))
+	keep[0], keep[len(r)-1] = true, true
+
+	var dp func(first, last int)
+	dp = func(first, last int) {
+		index, maximum := -1, tolerance
+
+		for i := first + 1; i < last; i++ {
+			if d := segmentDistance(r[i], r[first], r[last]); d > maximum {
+				index, maximum = i, d
+			}
+		}
+
+		if index < 0 {
+			return
+		}
+
+		keep[index] = true
+		dp(first, index)
+		dp(index, last)
+	}
+
+	dp(0, len(r)-1)
+
+	simplified := make(ring, 0, len(r))
+
+	for i, pt := range r {
+		if keep[i] {
+			simplified = append(simplified, pt)
+		}
+	}
+
+	if len(simplified) < 4 {
+		return r
+	}
+
+	return simplified
+}
+
+// segmentDistance returns the planar distance from the point to the segment.
+func segmentDistance(p, a, b [2]float64) float64 {
+	dx, dy := b[0]-a[0], b[1]-a[1]
+
+	t := 0.0
+	if l := dx*dx + dy*dy; l > 0 {
+		t = math.Max(0, math.Min(1, ((p[0]-a[0])*dx+(p[1]-a[1])*dy)/l))
+	}
+
+	return math.Hypot(p[0]-a[0]-t*dx, p[1]-a[1]-t*dy)
+}
+
+// sortedKeys returns sorted keys of the map.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
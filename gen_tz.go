@@ -0,0 +1,190 @@
+//go:build ignore
+
+// This program generates timezone_data.go from zone.tab and the link lines
+// of tzdata.zi of the IANA time zone database published at https://www.iana.org/time-zones.
+// It is invoked by go generate.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"sort"
+	"strings"
+)
+
+// primaryZones holds the zone of the capital for countries
+// whose first zone in zone.tab isn't the primary one.
+var primaryZones = map[string]string{
+	"AU": "Australia/Sydney",
+	"BR": "America/Sao_Paulo",
+	"CA": "America/Toronto",
+	"FM": "Pacific/Pohnpei",
+	"RU": "Europe/Moscow",
+	"UA": "Europe/Kyiv",
+	"UZ": "Asia/Tashkent",
+}
+
+func main() {
+	zonesPath := flag.String("zones", "data/zone.tab", "path to zone.tab file")
+	linksPath := flag.String("links", "data/tzlinks.zi", "path to file with tzdata.zi link lines")
+	out := flag.String("out", "timezone_data.go", "path to the generated file")
+	flag.Parse()
+
+	zones, err := readZones(*zonesPath)
+	if err != nil {
+		log.Fatalf("read %s: %v", *zonesPath, err)
+	}
+
+	zoneCountry := make(map[string]string)
+
+	for country, names := range zones {
+		for _, name := range names {
+			zoneCountry[name] = country
+		}
+
+		if primary, ok := primaryZones[country]; ok {
+			if err := moveFirst(names, primary); err != nil {
+				log.Fatalf("primary zone of %s: %v", country, err)
+			}
+		}
+	}
+
+	links, err := readLinks(*linksPath)
+	if err != nil {
+		log.Fatalf("read %s: %v", *linksPath, err)
+	}
+
+	var b bytes.Buffer
+
+	b.WriteString("// Code generated by gen_tz.go from " + *zonesPath + " and " + *linksPath + "; DO NOT EDIT.\n\n")
+	b.WriteString("package isocodes\n\n")
+	b.WriteString("// countryTimeZones holds IANA time zones of countries, the primary zone first.\n")
+	b.WriteString("var countryTimeZones = map[CountryCode][]string{\n")
+
+	for _, country := range sortedKeys(zones) {
+		fmt.Fprintf(&b, "\t%s: {%q", country, zones[country][0])
+
+		for _, name := range zones[country][1:] {
+			fmt.Fprintf(&b, ", %q", name)
+		}
+
+		b.WriteString("},\n")
+	}
+
+	b.WriteString("}\n\n")
+	b.WriteString("// timeZoneLinks holds backward compatible names of zones of countries.\n")
+	b.WriteString("var timeZoneLinks = map[string]string{\n")
+
+	for _, link := range sortedKeys(links) {
+		target := links[link]
+		if _, ok := zoneCountry[target]; !ok {
+			continue
+		}
+
+		if _, ok := zoneCountry[link]; ok {
+			continue
+		}
+
+		fmt.Fprintf(&b, "\t%q: %q,\n", link, target)
+	}
+
+	b.WriteString("}\n")
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatalf("format generated code: %v", err)
+	}
+
+	if err := os.WriteFile(*out, src, 0o644); err != nil {
+		log.Fatalf("write %s: %v", *out, err)
+	}
+}
+
+// readZones reads zone.tab into zone names keyed by the country code in the file order.
+func readZones(path string) (map[string][]string, error) {
+	zones := make(map[string][]string)
+
+	err := readLines(path, func(fields []string) error {
+		if len(fields) < 3 {
+			return fmt.Errorf("invalid line %q", strings.Join(fields, "\t"))
+		}
+
+		zones[fields[0]] = append(zones[fields[0]], fields[2])
+
+		return nil
+	})
+
+	return zones, err
+}
+
+// readLinks reads "L target link" lines into targets keyed by the link.
+func readLinks(path string) (map[string]string, error) {
+	links := make(map[string]string)
+
+	err := readLines(path, func(fields []string) error {
+		if len(fields) != 3 || fields[0] != "L" {
+			return fmt.Errorf("invalid line %q", strings.Join(fields, " "))
+		}
+
+		links[fields[2]] = fields[1]
+
+		return nil
+	})
+
+	return links, err
+}
+
+// readLines calls fn for fields of each line of the file except comments.
+func readLines(path string, fn func(fields []string) error) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if err := fn(strings.Fields(line)); err != nil {
+			return err
+		}
+	}
+
+	return scanner.Err()
+}
+
+// moveFirst moves the name to the beginning of names.
+func moveFirst(names []string, name string) error {
+	for i, n := range names {
+		if n == name {
+			copy(names[1:i+1], names[:i])
+			names[0] = name
+
+			return nil
+		}
+	}
+
+	return fmt.Errorf("zone %q not found", name)
+}
+
+// sortedKeys returns sorted keys of the map.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
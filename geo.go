@@ -0,0 +1,364 @@
+package isocodes
+
+import "math"
+
+// EarthRadius holds the mean radius of the Earth in kilometers,
+// which is used for great-circle distances.
+const EarthRadius = 6371.0088
+
+// Coordinates represents geographic coordinates in decimal degrees.
+type Coordinates struct {
+	Lat float64 `json:"lat"`
+	Lon float64 `json:"lon"`
+}
+
+// IsZero reports whether the coordinates are the zero value.
+func (p Coordinates) IsZero() bool { return p == Coordinates{} }
+
+// DistanceTo returns the great-circle distance in kilometers
+// between the coordinates calculated by the haversine formula.
+func (p Coordinates) DistanceTo(o Coordinates) float64 {
+	lat1, lat2 := radians(p.Lat), radians(o.Lat)
+	dLat, dLon := lat2-lat1, radians(o.Lon-p.Lon)
+
+	h := math.Pow(math.Sin(dLat/2), 2) + math.Cos(lat1)*math.Cos(lat2)*math.Pow(math.Sin(dLon/2), 2)
+
+	return 2 * EarthRadius * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// BoundingBox represents a geographic bounding box in decimal degrees.
+// For boxes which cross the antimeridian, e.g. the box of RU, West is greater than East.
+type BoundingBox struct {
+	South float64 `json:"south"`
+	West  float64 `json:"west"`
+	North float64 `json:"north"`
+	East  float64 `json:"east"`
+}
+
+// Contains reports whether the coordinates are inside the bounding box.
+func (b BoundingBox) Contains(p Coordinates) bool {
+	if p.Lat < b.South || p.Lat > b.North {
+		return false
+	}
+
+	if b.West <= b.East {
+		return p.Lon >= b.West && p.Lon <= b.East
+	}
+
+	return p.Lon >= b.West || p.Lon <= b.East
+}
+
+// Capital returns the name of the capital city of the country, e.g. "Berlin" for DE.
+// For territories without a capital, e.g. AQ, it returns an empty string.
+func (c CountryCode) Capital() string { return countryGeography[c].capital }
+
+// CapitalCoordinates returns coordinates of the capital city of the country.
+// For territories without a capital it returns zero value.
+func (c CountryCode) CapitalCoordinates() Coordinates { return countryGeography[c].capitalAt }
+
+// Centroid returns the geometric center of the territory of the country.
+// For archipelagos scattered across the ocean, e.g. KI, it is the capital
+// rather than a point in the middle of the ocean.
+func (c CountryCode) Centroid() Coordinates { return countryGeography[c].centroid }
+
+// BoundingBox returns the bounding box of the territory of the country.
+func (c CountryCode) BoundingBox() BoundingBox { return countryGeography[c].bbox }
+
+// Distance returns the great-circle distance in kilometers between centroids
+// of the countries. It returns 0 if either country has no geography data.
+func Distance(a, b CountryCode) float64 {
+	ga, okA := countryGeography[a]
+	gb, okB := countryGeography[b]
+
+	if !okA || !okB {
+		return 0
+	}
+
+	return ga.centroid.DistanceTo(gb.centroid)
+}
+
+// Nearest returns the country with the centroid nearest to the coordinates.
+// It's a rough approximation: a point near the border of a large country
+// may be nearer to the centroid of its smaller neighbour.
+func Nearest(lat, lon float64) CountryCode {
+	var (
+		p       = Coordinates{Lat: lat, Lon: lon}
+		nearest CountryCode
+		minimum = math.Inf(1)
+	)
+
+	for c, g := range countryGeography {
+		d := p.DistanceTo(g.centroid)
+		if d < minimum || (d == minimum && c.String() < nearest.String()) {
+			nearest, minimum = c, d
+		}
+	}
+
+	return nearest
+}
+
+// radians converts degrees to radians.
+func radians(deg float64) float64 { return deg * math.Pi / 180 }
+
+// geography represents geographic data of the country.
+type geography struct {
+	capital   string
+	capitalAt Coordinates
+	centroid  Coordinates
+	bbox      BoundingBox
+}
+
+// countryGeography holds geographic data of countries. Centroids and bounding boxes
+// are derived from Natural Earth 1:110m admin-0 boundaries, territories which are
+// too small for that scale have approximate values.
+var countryGeography = map[CountryCode]geography{
+	AD: {"Andorra la Vella", Coordinates{42.51, 1.52}, Coordinates{42.55, 1.6}, BoundingBox{42.43, 1.41, 42.66, 1.79}},
+	AE: {"Abu Dhabi", Coordinates{24.45, 54.38}, Coordinates{23.87, 54.21}, BoundingBox{22.5, 51.58, 26.06, 56.4}},
+	AF: {"Kabul", Coordinates{34.53, 69.17}, Coordinates{33.86, 66.09}, BoundingBox{29.32, 60.53, 38.49, 75.16}},
+	AG: {"Saint John's", Coordinates{17.12, -61.85}, Coordinates{17.36, -62}, BoundingBox{16.99, -62.35, 17.73, -61.66}},
+	AI: {"The Valley", Coordinates{18.22, -63.06}, Coordinates{18.38, -63.17}, BoundingBox{18.15, -63.43, 18.6, -62.92}},
+	AL: {"Tirana", Coordinates{41.33, 19.82}, Coordinates{41.14, 20.03}, BoundingBox{39.62, 19.3, 42.69, 21.02}},
+	AM: {"Yerevan", Coordinates{40.18, 44.51}, Coordinates{40.22, 45}, BoundingBox{38.74, 43.58, 41.25, 46.51}},
+	AO: {"Luanda", Coordinates{-8.84, 13.23}, Coordinates{-12.25, 17.47}, BoundingBox{-17.93, 11.64, -4.44, 24.08}},
+	AQ: {"", Coordinates{}, Coordinates{-90, 0}, BoundingBox{-90, -180, -63.27, 180}},
+	AR: {"Buenos Aires", Coordinates{-34.6, -58.38}, Coordinates{-35.45, -65.18}, BoundingBox{-55.25, -73.42, -21.83, -53.63}},
+	AS: {"Pago Pago", Coordinates{-14.28, -170.7}, Coordinates{-14.28, -170.7}, BoundingBox{-14.6, -171.1, -11.05, -168.14}},
+	AT: {"Vienna", Coordinates{48.21, 16.37}, Coordinates{47.61, 14.08}, BoundingBox{46.43, 9.48, 49.04, 16.98}},
+	AU: {"Canberra", Coordinates{-35.28, 149.13}, Coordinates{-25.73, 134.5}, BoundingBox{-43.63, 113.34, -10.67, 153.57}},
+	AW: {"Oranjestad", Coordinates{12.52, -70.03}, Coordinates{12.52, -69.97}, BoundingBox{12.41, -70.07, 12.63, -69.87}},
+	AX: {"Mariehamn", Coordinates{60.1, 19.94}, Coordinates{60.2, 20.18}, BoundingBox{59.9, 19.26, 60.49, 21.1}},
+	AZ: {"Baku", Coordinates{40.41, 49.87}, Coordinates{40.22, 47.55}, BoundingBox{38.27, 44.79, 41.86, 50.39}},
+	BA: {"Sarajevo", Coordinates{43.86, 18.41}, Coordinates{44.18, 17.82}, BoundingBox{42.65, 15.75, 45.23, 19.6}},
+	BB: {"Bridgetown", Coordinates{13.1, -59.62}, Coordinates{13.19, -59.53}, BoundingBox{13.04, -59.65, 13.34, -59.42}},
+	BD: {"Dhaka", Coordinates{23.81, 90.41}, Coordinates{23.84, 90.27}, BoundingBox{20.67, 88.08, 26.45, 92.67}},
+	BE: {"Brussels", Coordinates{50.85, 4.35}, Coordinates{50.65, 4.58}, BoundingBox{49.53, 2.51, 51.48, 6.16}},
+	BF: {"Ouagadougou", Coordinates{12.37, -1.52}, Coordinates{12.31, -1.78}, BoundingBox{9.61, -5.47, 15.12, 2.18}},
+	BG: {"Sofia", Coordinates{42.7, 23.32}, Coordinates{42.75, 25.2}, BoundingBox{41.23, 22.38, 44.23, 28.56}},
+	BH: {"Manama", Coordinates{26.23, 50.59}, Coordinates{26.06, 50.6}, BoundingBox{25.79, 50.38, 26.33, 50.82}},
+	BI: {"Gitega", Coordinates{-3.43, 29.93}, Coordinates{-3.38, 29.91}, BoundingBox{-4.5, 29.02, -2.35, 30.75}},
+	BJ: {"Porto-Novo", Coordinates{6.5, 2.6}, Coordinates{9.65, 2.34}, BoundingBox{6.14, 0.77, 12.24, 3.8}},
+	BL: {"Gustavia", Coordinates{17.9, -62.85}, Coordinates{17.92, -62.84}, BoundingBox{17.87, -62.88, 17.97, -62.79}},
+	BM: {"Hamilton", Coordinates{32.29, -64.78}, Coordinates{32.32, -64.77}, BoundingBox{32.25, -64.89, 32.39, -64.64}},
+	BN: {"Bandar Seri Begawan", Coordinates{4.9, 114.94}, Coordinates{4.69, 114.92}, BoundingBox{4.01, 114.2, 5.45, 115.45}},
+	BO: {"Sucre", Coordinates{-19.05, -65.26}, Coordinates{-16.73, -64.64}, BoundingBox{-22.87, -69.59, -9.76, -57.5}},
+	BQ: {"Kralendijk", Coordinates{12.15, -68.27}, Coordinates{12.15, -68.27}, BoundingBox{12.02, -68.42, 17.65, -62.94}},
+	BR: {"Brasília", Coordinates{-15.79, -47.88}, Coordinates{-10.81, -53.05}, BoundingBox{-33.77, -73.99, 5.24, -34.73}},
+	BS: {"Nassau", Coordinates{25.05, -77.35}, Coordinates{25.52, -77.93}, BoundingBox{23.71, -78.98, 27.04, -77}},
+	BT: {"Thimphu", Coordinates{27.47, 89.64}, Coordinates{27.43, 90.47}, BoundingBox{26.72, 88.81, 28.3, 92.1}},
+	BV: {"", Coordinates{}, Coordinates{-54.42, 3.41}, BoundingBox{-54.46, 3.33, -54.38, 3.49}},
+	BW: {"Gaborone", Coordinates{-24.63, 25.92}, Coordinates{-22.1, 23.77}, BoundingBox{-26.83, 19.9, -17.66, 29.43}},
+	BY: {"Minsk", Coordinates{53.9, 27.56}, Coordinates{53.51, 27.98}, BoundingBox{51.32, 23.2, 56.17, 32.69}},
+	BZ: {"Belmopan", Coordinates{17.25, -88.76}, Coordinates{17.2, -88.7}, BoundingBox{15.89, -89.23, 18.5, -88.11}},
+	CA: {"Ottawa", Coordinates{45.42, -75.7}, Coordinates{61.47, -98.14}, BoundingBox{41.68, -141, 83.23, -52.65}},
+	CC: {"West Island", Coordinates{-12.19, 96.83}, Coordinates{-12.02, 96.87}, BoundingBox{-12.21, 96.81, -11.82, 96.93}},
+	CD: {"Kinshasa", Coordinates{-4.32, 15.31}, Coordinates{-2.85, 23.58}, BoundingBox{-13.26, 12.18, 5.26, 31.17}},
+	CF: {"Bangui", Coordinates{4.39, 18.56}, Coordinates{6.54, 20.37}, BoundingBox{2.27, 14.46, 11.14, 27.37}},
+	CG: {"Brazzaville", Coordinates{-4.27, 15.27}, Coordinates{-0.84, 15.13}, BoundingBox{-5.04, 11.09, 3.73, 18.45}},
+	CH: {"Bern", Coordinates{46.95, 7.45}, Coordinates{46.79, 8.12}, BoundingBox{45.78, 6.02, 47.83, 10.44}},
+	CI: {"Yamoussoukro", Coordinates{6.83, -5.29}, Coordinates{7.55, -5.61}, BoundingBox{4.34, -8.6, 10.52, -2.56}},
+	CK: {"Avarua", Coordinates{-21.21, -159.78}, Coordinates{-21.21, -159.78}, BoundingBox{-21.96, -165.85, -8.95, -157.31}},
+	CL: {"Santiago", Coordinates{-33.45, -70.67}, Coordinates{-39.05, -71.52}, BoundingBox{-55.61, -75.64, -17.58, -66.96}},
+	CM: {"Yaoundé", Coordinates{3.87, 11.52}, Coordinates{5.66, 12.61}, BoundingBox{1.73, 8.49, 12.86, 16.01}},
+	CN: {"Beijing", Coordinates{39.9, 116.41}, Coordinates{36.56, 103.88}, BoundingBox{18.2, 73.68, 53.46, 135.03}},
+	CO: {"Bogotá", Coordinates{4.71, -74.07}, Coordinates{3.93, -73.08}, BoundingBox{-4.3, -78.99, 12.44, -66.88}},
+	CR: {"San José", Coordinates{9.93, -84.08}, Coordinates{9.97, -84.18}, BoundingBox{8.23, -85.94, 11.22, -82.55}},
+	CU: {"Havana", Coordinates{23.11, -82.37}, Coordinates{21.63, -78.96}, BoundingBox{19.86, -84.97, 23.19, -74.18}},
+	CV: {"Praia", Coordinates{14.93, -23.51}, Coordinates{14.93, -23.51}, BoundingBox{14.8, -25.36, 17.21, -22.66}},
+	CW: {"Willemstad", Coordinates{12.11, -68.93}, Coordinates{12.21, -68.95}, BoundingBox{12.03, -69.17, 12.39, -68.73}},
+	CX: {"Flying Fish Cove", Coordinates{-10.42, 105.68}, Coordinates{-10.5, 105.62}, BoundingBox{-10.58, 105.53, -10.41, 105.72}},
+	CY: {"Nicosia", Coordinates{35.19, 33.38}, Coordinates{35.05, 33.24}, BoundingBox{34.57, 32.26, 35.67, 34.58}},
+	CZ: {"Prague", Coordinates{50.08, 14.44}, Coordinates{49.78, 15.33}, BoundingBox{48.56, 12.24, 51.12, 18.85}},
+	DE: {"Berlin", Coordinates{52.52, 13.4}, Coordinates{51.13, 10.29}, BoundingBox{47.3, 5.99, 54.98, 15.02}},
+	DJ: {"Djibouti", Coordinates{11.59, 43.15}, Coordinates{11.77, 42.5}, BoundingBox{10.93, 41.66, 12.7, 43.32}},
+	DK: {"Copenhagen", Coordinates{55.68, 12.57}, Coordinates{56.06, 9.88}, BoundingBox{54.8, 8.09, 57.73, 12.69}},
+	DM: {"Roseau", Coordinates{15.3, -61.39}, Coordinates{15.42, -61.37}, BoundingBox{15.2, -61.49, 15.64, -61.24}},
+	DO: {"Santo Domingo", Coordinates{18.49, -69.93}, Coordinates{18.88, -70.46}, BoundingBox{17.6, -71.95, 19.88, -68.32}},
+	DZ: {"Algiers", Coordinates{36.75, 3.06}, Coordinates{28.19, 2.6}, BoundingBox{19.06, -8.68, 37.12, 12}},
+	EC: {"Quito", Coordinates{-0.18, -78.47}, Coordinates{-1.45, -78.38}, BoundingBox{-4.96, -80.97, 1.38, -75.23}},
+	EE: {"Tallinn", Coordinates{59.44, 24.75}, Coordinates{58.64, 25.82}, BoundingBox{57.47, 23.34, 59.61, 28.13}},
+	EG: {"Cairo", Coordinates{30.04, 31.24}, Coordinates{26.51, 29.84}, BoundingBox{22, 24.7, 31.59, 36.87}},
+	EH: {"Laayoune", Coordinates{27.15, -13.2}, Coordinates{24.29, -12.14}, BoundingBox{21, -17.06, 27.66, -8.67}},
+	ER: {"Asmara", Coordinates{15.32, 38.93}, Coordinates{15.43, 38.68}, BoundingBox{12.46, 36.32, 18, 43.08}},
+	ES: {"Madrid", Coordinates{40.42, -3.7}, Coordinates{40.35, -3.62}, BoundingBox{35.95, -9.39, 43.75, 3.04}},
+	ET: {"Addis Ababa", Coordinates{9.03, 38.74}, Coordinates{8.65, 39.55}, BoundingBox{3.42, 32.95, 14.96, 47.79}},
+	FI: {"Helsinki", Coordinates{60.17, 24.94}, Coordinates{64.5, 26.21}, BoundingBox{59.85, 20.65, 70.16, 31.52}},
+	FJ: {"Suva", Coordinates{-18.14, 178.44}, Coordinates{-17.32, 178.56}, BoundingBox{-18.29, 177.29, -16.02, -179.79}},
+	FK: {"Stanley", Coordinates{-51.69, -57.86}, Coordinates{-51.71, -59.42}, BoundingBox{-52.3, -61.2, -51.1, -57.75}},
+	FM: {"Palikir", Coordinates{6.92, 158.16}, Coordinates{6.92, 158.16}, BoundingBox{1.03, 137.33, 10.09, 163.04}},
+	FO: {"Tórshavn", Coordinates{62.01, -6.77}, Coordinates{61.89, -6.97}, BoundingBox{61.39, -7.69, 62.4, -6.25}},
+	FR: {"Paris", Coordinates{48.86, 2.35}, Coordinates{46.54, 2.45}, BoundingBox{41.38, -4.59, 51.15, 9.56}},
+	GA: {"Libreville", Coordinates{0.42, 9.47}, Coordinates{-0.65, 11.69}, BoundingBox{-3.98, 8.8, 2.33, 14.43}},
+	GB: {"London", Coordinates{51.51, -0.13}, Coordinates{53.91, -2.85}, BoundingBox{49.96, -7.57, 58.64, 1.68}},
+	GD: {"Saint George's", Coordinates{12.06, -61.75}, Coordinates{12.25, -61.59}, BoundingBox{11.98, -61.8, 12.53, -61.38}},
+	GE: {"Tbilisi", Coordinates{41.72, 44.78}, Coordinates{42.16, 43.48}, BoundingBox{41.06, 39.96, 43.55, 46.64}},
+	GF: {"Cayenne", Coordinates{4.92, -52.33}, Coordinates{3.91, -53.24}, BoundingBox{2.05, -54.52, 5.76, -51.66}},
+	GG: {"Saint Peter Port", Coordinates{49.46, -2.54}, Coordinates{49.58, -2.42}, BoundingBox{49.41, -2.68, 49.74, -2.16}},
+	GH: {"Accra", Coordinates{5.6, -0.19}, Coordinates{7.93, -1.24}, BoundingBox{4.71, -3.24, 11.1, 1.06}},
+	GI: {"Gibraltar", Coordinates{36.14, -5.35}, Coordinates{36.13, -5.36}, BoundingBox{36.11, -5.37, 36.16, -5.34}},
+	GL: {"Nuuk", Coordinates{64.18, -51.72}, Coordinates{74.77, -41.5}, BoundingBox{60.04, -73.3, 83.65, -12.21}},
+	GM: {"Banjul", Coordinates{13.45, -16.58}, Coordinates{13.48, -15.43}, BoundingBox{13.13, -16.84, 13.88, -13.84}},
+	GN: {"Conakry", Coordinates{9.64, -13.58}, Coordinates{10.45, -11.06}, BoundingBox{7.31, -15.13, 12.59, -7.83}},
+	GP: {"Basse-Terre", Coordinates{16, -61.73}, Coordinates{16.18, -61.41}, BoundingBox{15.83, -61.81, 16.52, -61}},
+	GQ: {"Malabo", Coordinates{3.75, 8.78}, Coordinates{1.65, 10.37}, BoundingBox{-1.47, 5.6, 3.79, 11.34}},
+	GR: {"Athens", Coordinates{37.98, 23.73}, Coordinates{39.07, 22.72}, BoundingBox{34.92, 20.15, 41.83, 26.6}},
+	GS: {"King Edward Point", Coordinates{-54.28, -36.49}, Coordinates{-54.28, -36.49}, BoundingBox{-59.48, -38.03, -53.97, -26.24}},
+	GT: {"Guatemala City", Coordinates{14.63, -90.51}, Coordinates{15.7, -90.37}, BoundingBox{13.74, -92.23, 17.82, -88.23}},
+	GU: {"Hagåtña", Coordinates{13.48, 144.75}, Coordinates{13.44, 144.79}, BoundingBox{13.23, 144.62, 13.65, 144.96}},
+	GW: {"Bissau", Coordinates{11.86, -15.6}, Coordinates{12.02, -15.11}, BoundingBox{11.04, -16.68, 12.63, -13.7}},
+	GY: {"Georgetown", Coordinates{6.8, -58.16}, Coordinates{4.79, -58.97}, BoundingBox{1.27, -61.41, 8.37, -56.54}},
+	HK: {"Hong Kong", Coordinates{22.28, 114.16}, Coordinates{22.35, 114.13}, BoundingBox{22.15, 113.83, 22.56, 114.44}},
+	HM: {"", Coordinates{}, Coordinates{-53.08, 73.52}, BoundingBox{-53.2, 72.58, -52.9, 73.86}},
+	HN: {"Tegucigalpa", Coordinates{14.07, -87.19}, Coordinates{14.82, -86.59}, BoundingBox{12.98, -89.35, 16.01, -83.15}},
+	HR: {"Zagreb", Coordinates{45.81, 15.98}, Coordinates{45.02, 16.57}, BoundingBox{42.48, 13.66, 46.5, 19.39}},
+	HT: {"Port-au-Prince", Coordinates{18.54, -72.34}, Coordinates{18.9, -72.66}, BoundingBox{18.03, -74.46, 19.92, -71.62}},
+	HU: {"Budapest", Coordinates{47.5, 19.04}, Coordinates{47.2, 19.36}, BoundingBox{45.76, 16.2, 48.62, 22.71}},
+	ID: {"Jakarta", Coordinates{-6.21, 106.85}, Coordinates{-2.22, 117.42}, BoundingBox{-10.36, 95.29, 5.48, 141.03}},
+	IE: {"Dublin", Coordinates{53.35, -6.26}, Coordinates{53.18, -8.01}, BoundingBox{51.67, -9.98, 55.13, -6.03}},
+	IL: {"Jerusalem", Coordinates{31.77, 35.21}, Coordinates{31.48, 35}, BoundingBox{29.5, 34.27, 33.28, 35.84}},
+	IM: {"Douglas", Coordinates{54.15, -4.48}, Coordinates{54.23, -4.57}, BoundingBox{54.04, -4.83, 54.42, -4.31}},
+	IN: {"New Delhi", Coordinates{28.61, 77.21}, Coordinates{22.93, 79.59}, BoundingBox{7.97, 68.18, 35.49, 97.4}},
+	IO: {"Diego Garcia", Coordinates{-7.31, 72.41}, Coordinates{-7.31, 72.41}, BoundingBox{-7.44, 71.26, -5.23, 72.49}},
+	IQ: {"Baghdad", Coordinates{33.31, 44.36}, Coordinates{33.04, 43.76}, BoundingBox{29.1, 38.79, 37.39, 48.57}},
+	IR: {"Tehran", Coordinates{35.69, 51.39}, Coordinates{32.52, 54.29}, BoundingBox{25.08, 44.11, 39.71, 63.32}},
+	IS: {"Reykjavík", Coordinates{64.15, -21.94}, Coordinates{65.07, -18.76}, BoundingBox{63.5, -24.33, 66.53, -13.61}},
+	IT: {"Rome", Coordinates{41.9, 12.5}, Coordinates{42.75, 12.14}, BoundingBox{36.62, 6.75, 47.12, 18.48}},
+	JE: {"Saint Helier", Coordinates{49.19, -2.11}, Coordinates{49.21, -2.13}, BoundingBox{49.16, -2.26, 49.26, -2.01}},
+	JM: {"Kingston", Coordinates{17.97, -76.79}, Coordinates{18.14, -77.32}, BoundingBox{17.7, -78.34, 18.52, -76.2}},
+	JO: {"Amman", Coordinates{31.95, 35.93}, Coordinates{31.25, 36.78}, BoundingBox{29.2, 34.92, 33.38, 39.2}},
+	JP: {"Tokyo", Coordinates{35.68, 139.69}, Coordinates{37.66, 138.06}, BoundingBox{31.03, 129.41, 45.55, 145.54}},
+	KE: {"Nairobi", Coordinates{-1.29, 36.82}, Coordinates{0.6, 37.79}, BoundingBox{-4.68, 33.89, 5.51, 41.86}},
+	KG: {"Bishkek", Coordinates{42.87, 74.59}, Coordinates{41.51, 74.62}, BoundingBox{39.28, 69.46, 43.3, 80.26}},
+	KH: {"Phnom Penh", Coordinates{11.56, 104.92}, Coordinates{12.68, 104.88}, BoundingBox{10.49, 102.35, 14.57, 107.61}},
+	KI: {"South Tarawa", Coordinates{1.33, 172.98}, Coordinates{1.33, 172.98}, BoundingBox{-11.45, 169.53, 4.72, -150.21}},
+	KM: {"Moroni", Coordinates{-11.7, 43.26}, Coordinates{-11.89, 43.88}, BoundingBox{-12.42, 43.22, -11.36, 44.54}},
+	KN: {"Basseterre", Coordinates{17.3, -62.72}, Coordinates{17.26, -62.7}, BoundingBox{17.09, -62.87, 17.42, -62.54}},
+	KP: {"Pyongyang", Coordinates{39.04, 125.76}, Coordinates{40.14, 127.17}, BoundingBox{37.67, 124.27, 42.99, 130.78}},
+	KR: {"Seoul", Coordinates{37.57, 126.98}, Coordinates{36.43, 127.82}, BoundingBox{34.39, 126.12, 38.61, 129.47}},
+	KW: {"Kuwait City", Coordinates{29.38, 47.99}, Coordinates{29.31, 47.6}, BoundingBox{28.53, 46.57, 30.06, 48.42}},
+	KY: {"George Town", Coordinates{19.29, -81.37}, Coordinates{19.51, -80.57}, BoundingBox{19.26, -81.42, 19.76, -79.72}},
+	KZ: {"Astana", Coordinates{51.17, 71.45}, Coordinates{48.19, 67.28}, BoundingBox{40.66, 46.47, 55.39, 87.36}},
+	LA: {"Vientiane", Coordinates{17.98, 102.63}, Coordinates{18.44, 103.75}, BoundingBox{13.88, 100.12, 22.46, 107.56}},
+	LB: {"Beirut", Coordinates{33.89, 35.5}, Coordinates{33.91, 35.87}, BoundingBox{33.09, 35.13, 34.64, 36.61}},
+	LC: {"Castries", Coordinates{14.01, -60.99}, Coordinates{13.91, -60.97}, BoundingBox{13.71, -61.08, 14.11, -60.87}},
+	LI: {"Vaduz", Coordinates{47.14, 9.52}, Coordinates{47.16, 9.55}, BoundingBox{47.05, 9.47, 47.27, 9.64}},
+	LK: {"Sri Jayawardenepura Kotte", Coordinates{6.89, 79.9}, Coordinates{7.7, 80.67}, BoundingBox{5.97, 79.7, 9.82, 81.79}},
+	LR: {"Monrovia", Coordinates{6.3, -10.8}, Coordinates{6.43, -9.41}, BoundingBox{4.36, -11.44, 8.54, -7.54}},
+	LS: {"Maseru", Coordinates{-29.31, 27.48}, Coordinates{-29.63, 28.17}, BoundingBox{-30.65, 27, -28.65, 29.33}},
+	LT: {"Vilnius", Coordinates{54.69, 25.28}, Coordinates{55.28, 23.88}, BoundingBox{53.91, 21.06, 56.37, 26.59}},
+	LU: {"Luxembourg", Coordinates{49.61, 6.13}, Coordinates{49.77, 5.97}, BoundingBox{49.44, 5.67, 50.13, 6.24}},
+	LV: {"Riga", Coordinates{56.95, 24.11}, Coordinates{56.81, 24.83}, BoundingBox{55.62, 21.06, 57.97, 28.18}},
+	LY: {"Tripoli", Coordinates{32.89, 13.19}, Coordinates{27, 17.97}, BoundingBox{19.58, 9.32, 33.14, 25.16}},
+	MA: {"Rabat", Coordinates{34.02, -6.84}, Coordinates{29.89, -8.42}, BoundingBox{21.42, -17.02, 35.76, -1.12}},
+	MC: {"Monaco", Coordinates{43.74, 7.42}, Coordinates{43.73, 7.43}, BoundingBox{43.72, 7.41, 43.75, 7.44}},
+	MD: {"Chișinău", Coordinates{47.01, 28.86}, Coordinates{47.2, 28.41}, BoundingBox{45.49, 26.62, 48.47, 30.02}},
+	ME: {"Podgorica", Coordinates{42.44, 19.26}, Coordinates{42.79, 19.29}, BoundingBox{41.88, 18.45, 43.52, 20.34}},
+	MF: {"Marigot", Coordinates{18.07, -63.08}, Coordinates{18.09, -63.08}, BoundingBox{18.05, -63.15, 18.13, -63.01}},
+	MG: {"Antananarivo", Coordinates{-18.88, 47.51}, Coordinates{-19.36, 46.69}, BoundingBox{-25.6, 43.25, -12.04, 50.48}},
+	MH: {"Majuro", Coordinates{7.09, 171.38}, Coordinates{7.09, 171.38}, BoundingBox{4.57, 160.8, 14.62, 172.17}},
+	MK: {"Skopje", Coordinates{42, 21.43}, Coordinates{41.61, 21.7}, BoundingBox{40.84, 20.46, 42.32, 22.95}},
+	ML: {"Bamako", Coordinates{12.64, -8}, Coordinates{17.27, -3.54}, BoundingBox{10.1, -12.17, 24.97, 4.27}},
+	MM: {"Naypyidaw", Coordinates{19.76, 96.08}, Coordinates{21.02, 96.51}, BoundingBox{9.93, 92.3, 28.34, 101.18}},
+	MN: {"Ulaanbaatar", Coordinates{47.89, 106.91}, Coordinates{46.82, 102.95}, BoundingBox{41.6, 87.75, 52.05, 119.77}},
+	MO: {"Macao", Coordinates{22.2, 113.54}, Coordinates{22.16, 113.56}, BoundingBox{22.11, 113.53, 22.22, 113.6}},
+	MP: {"Saipan", Coordinates{15.18, 145.75}, Coordinates{15.18, 145.75}, BoundingBox{14.11, 144.89, 20.55, 145.87}},
+	MQ: {"Fort-de-France", Coordinates{14.6, -61.07}, Coordinates{14.64, -61.02}, BoundingBox{14.39, -61.23, 14.88, -60.81}},
+	MR: {"Nouakchott", Coordinates{18.08, -15.98}, Coordinates{20.21, -10.33}, BoundingBox{14.62, -17.06, 27.4, -4.92}},
+	MS: {"Brades", Coordinates{16.79, -62.21}, Coordinates{16.75, -62.19}, BoundingBox{16.67, -62.24, 16.82, -62.14}},
+	MT: {"Valletta", Coordinates{35.9, 14.51}, Coordinates{35.94, 14.38}, BoundingBox{35.79, 14.18, 36.08, 14.58}},
+	MU: {"Port Louis", Coordinates{-20.16, 57.5}, Coordinates{-20.16, 57.5}, BoundingBox{-20.53, 56.51, -10.31, 63.5}},
+	MV: {"Malé", Coordinates{4.18, 73.51}, Coordinates{4.18, 73.51}, BoundingBox{-0.69, 72.64, 7.11, 73.76}},
+	MW: {"Lilongwe", Coordinates{-13.96, 33.79}, Coordinates{-13.17, 34.19}, BoundingBox{-16.8, 32.69, -9.23, 35.77}},
+	MX: {"Mexico City", Coordinates{19.43, -99.13}, Coordinates{23.94, -102.58}, BoundingBox{14.54, -117.13, 32.72, -86.81}},
+	MY: {"Kuala Lumpur", Coordinates{3.14, 101.69}, Coordinates{3.73, 109.7}, BoundingBox{0.77, 100.09, 6.93, 119.18}},
+	MZ: {"Maputo", Coordinates{-25.97, 32.57}, Coordinates{-17.23, 35.47}, BoundingBox{-26.74, 30.18, -10.32, 40.78}},
+	NA: {"Windhoek", Coordinates{-22.56, 17.08}, Coordinates{-22.1, 17.16}, BoundingBox{-29.05, 11.73, -16.94, 25.08}},
+	NC: {"Nouméa", Coordinates{-22.28, 166.46}, Coordinates{-21.26, 165.53}, BoundingBox{-22.4, 164.03, -20.11, 167.12}},
+	NE: {"Niamey", Coordinates{13.51, 2.13}, Coordinates{17.35, 9.32}, BoundingBox{11.66, 0.3, 23.47, 15.9}},
+	NF: {"Kingston", Coordinates{-29.06, 167.96}, Coordinates{-29.06, 167.95}, BoundingBox{-29.14, 167.91, -28.99, 168}},
+	NG: {"Abuja", Coordinates{9.08, 7.4}, Coordinates{9.55, 8}, BoundingBox{4.24, 2.69, 13.87, 14.58}},
+	NI: {"Managua", Coordinates{12.11, -86.24}, Coordinates{12.85, -85.02}, BoundingBox{10.73, -87.67, 15.02, -83.15}},
+	NL: {"Amsterdam", Coordinates{52.37, 4.9}, Coordinates{52.3, 5.51}, BoundingBox{50.8, 3.31, 53.51, 7.09}},
+	NO: {"Oslo", Coordinates{59.91, 10.75}, Coordinates{64.54, 14.24}, BoundingBox{58.08, 4.99, 71.19, 31.29}},
+	NP: {"Kathmandu", Coordinates{27.72, 85.32}, Coordinates{28.24, 84.01}, BoundingBox{26.4, 80.09, 30.42, 88.17}},
+	NR: {"Yaren", Coordinates{-0.55, 166.92}, Coordinates{-0.53, 166.93}, BoundingBox{-0.56, 166.9, -0.5, 166.96}},
+	NU: {"Alofi", Coordinates{-19.06, -169.92}, Coordinates{-19.05, -169.86}, BoundingBox{-19.15, -169.95, -18.95, -169.77}},
+	NZ: {"Wellington", Coordinates{-41.29, 174.78}, Coordinates{-41.66, 172.7}, BoundingBox{-46.64, 166.51, -34.45, 178.52}},
+	OM: {"Muscat", Coordinates{23.59, 58.41}, Coordinates{20.61, 56.1}, BoundingBox{16.65, 52, 26.4, 59.81}},
+	PA: {"Panama City", Coordinates{8.98, -79.52}, Coordinates{8.53, -80.11}, BoundingBox{7.22, -82.97, 9.61, -77.24}},
+	PE: {"Lima", Coordinates{-12.05, -77.04}, Coordinates{-9.19, -74.39}, BoundingBox{-18.35, -81.41, -0.06, -68.67}},
+	PF: {"Papeete", Coordinates{-17.54, -149.57}, Coordinates{-17.54, -149.57}, BoundingBox{-27.65, -154.73, -7.9, -134.93}},
+	PG: {"Port Moresby", Coordinates{-9.44, 147.18}, Coordinates{-6.45, 145.32}, BoundingBox{-10.65, 141, -2.5, 156.02}},
+	PH: {"Manila", Coordinates{14.6, 120.98}, Coordinates{11.76, 122.9}, BoundingBox{5.58, 117.17, 18.51, 126.54}},
+	PK: {"Islamabad", Coordinates{33.68, 73.05}, Coordinates{29.97, 69.41}, BoundingBox{23.69, 60.87, 37.13, 77.84}},
+	PL: {"Warsaw", Coordinates{52.23, 21.01}, Coordinates{52.15, 19.31}, BoundingBox{49.03, 14.07, 54.85, 24.03}},
+	PM: {"Saint-Pierre", Coordinates{46.78, -56.18}, Coordinates{46.95, -56.27}, BoundingBox{46.75, -56.41, 47.15, -56.12}},
+	PN: {"Adamstown", Coordinates{-25.07, -130.1}, Coordinates{-25.07, -130.1}, BoundingBox{-25.08, -130.75, -23.92, -124.77}},
+	PR: {"San Juan", Coordinates{18.47, -66.11}, Coordinates{18.24, -66.48}, BoundingBox{17.95, -67.24, 18.52, -65.59}},
+	PS: {"Ramallah", Coordinates{31.9, 35.2}, Coordinates{31.94, 35.27}, BoundingBox{31.35, 34.93, 32.53, 35.55}},
+	PT: {"Lisbon", Coordinates{38.72, -9.14}, Coordinates{39.63, -8.06}, BoundingBox{36.84, -9.53, 42.28, -6.39}},
+	PW: {"Ngerulmud", Coordinates{7.5, 134.62}, Coordinates{7.5, 134.62}, BoundingBox{2.8, 131.12, 8.1, 134.72}},
+	PY: {"Asunción", Coordinates{-25.26, -57.58}, Coordinates{-23.25, -58.39}, BoundingBox{-27.55, -62.69, -19.34, -54.29}},
+	QA: {"Doha", Coordinates{25.29, 51.53}, Coordinates{25.32, 51.18}, BoundingBox{24.56, 50.74, 26.11, 51.61}},
+	RE: {"Saint-Denis", Coordinates{-20.88, 55.45}, Coordinates{-21.13, 55.53}, BoundingBox{-21.39, 55.22, -20.87, 55.84}},
+	RO: {"Bucharest", Coordinates{44.43, 26.1}, Coordinates{45.86, 24.94}, BoundingBox{43.69, 20.22, 48.22, 29.63}},
+	RS: {"Belgrade", Coordinates{44.79, 20.45}, Coordinates{44.23, 20.82}, BoundingBox{42.25, 18.83, 46.17, 22.99}},
+	RU: {"Moscow", Coordinates{55.76, 37.62}, Coordinates{61.98, 99.8}, BoundingBox{41.15, 19.66, 81.25, -169.9}},
+	RW: {"Kigali", Coordinates{-1.94, 30.06}, Coordinates{-2.01, 29.92}, BoundingBox{-2.92, 29.02, -1.13, 30.82}},
+	SA: {"Riyadh", Coordinates{24.71, 46.68}, Coordinates{24.12, 44.52}, BoundingBox{16.35, 34.63, 32.16, 55.67}},
+	SB: {"Honiara", Coordinates{-9.43, 159.95}, Coordinates{-8.85, 159.97}, BoundingBox{-10.83, 156.49, -6.6, 162.4}},
+	SC: {"Victoria", Coordinates{-4.62, 55.45}, Coordinates{-4.62, 55.45}, BoundingBox{-10.23, 46.2, -3.71, 56.29}},
+	SD: {"Khartoum", Coordinates{15.5, 32.56}, Coordinates{15.99, 29.86}, BoundingBox{8.62, 21.94, 22, 38.41}},
+	SE: {"Stockholm", Coordinates{59.33, 18.07}, Coordinates{62.81, 16.6}, BoundingBox{55.36, 11.03, 69.11, 23.9}},
+	SG: {"Singapore", Coordinates{1.29, 103.85}, Coordinates{1.31, 103.84}, BoundingBox{1.16, 103.6, 1.47, 104.09}},
+	SH: {"Jamestown", Coordinates{-15.92, -5.72}, Coordinates{-15.92, -5.72}, BoundingBox{-40.4, -14.42, -7.88, -5.64}},
+	SI: {"Ljubljana", Coordinates{46.06, 14.51}, Coordinates{46.13, 14.94}, BoundingBox{45.45, 13.7, 46.85, 16.56}},
+	SJ: {"Longyearbyen", Coordinates{78.22, 15.65}, Coordinates{78.99, 18.07}, BoundingBox{76.77, 10.44, 80.66, 27.41}},
+	SK: {"Bratislava", Coordinates{48.15, 17.11}, Coordinates{48.73, 19.51}, BoundingBox{47.76, 16.88, 49.57, 22.56}},
+	SL: {"Freetown", Coordinates{8.48, -13.23}, Coordinates{8.53, -11.8}, BoundingBox{6.79, -13.25, 10.05, -10.23}},
+	SM: {"San Marino", Coordinates{43.94, 12.45}, Coordinates{43.94, 12.46}, BoundingBox{43.89, 12.4, 43.99, 12.52}},
+	SN: {"Dakar", Coordinates{14.72, -17.47}, Coordinates{14.35, -14.51}, BoundingBox{12.33, -17.63, 16.6, -11.47}},
+	SO: {"Mogadishu", Coordinates{2.05, 45.32}, Coordinates{6.05, 45.86}, BoundingBox{-1.68, 40.98, 12.02, 51.13}},
+	SR: {"Paramaribo", Coordinates{5.85, -55.2}, Coordinates{4.12, -55.91}, BoundingBox{1.82, -58.04, 6.03, -53.96}},
+	SS: {"Juba", Coordinates{4.85, 31.58}, Coordinates{7.29, 30.2}, BoundingBox{3.51, 23.89, 12.25, 35.3}},
+	ST: {"São Tomé", Coordinates{0.34, 6.73}, Coordinates{0.84, 6.96}, BoundingBox{-0.02, 6.46, 1.7, 7.47}},
+	SV: {"San Salvador", Coordinates{13.69, -89.22}, Coordinates{13.73, -88.87}, BoundingBox{13.15, -90.1, 14.42, -87.72}},
+	SX: {"Philipsburg", Coordinates{18.03, -63.05}, Coordinates{18.04, -63.08}, BoundingBox{18, -63.14, 18.07, -63.01}},
+	SY: {"Damascus", Coordinates{33.51, 36.29}, Coordinates{35.01, 38.54}, BoundingBox{32.31, 35.7, 37.23, 42.35}},
+	SZ: {"Mbabane", Coordinates{-26.31, 31.14}, Coordinates{-26.49, 31.4}, BoundingBox{-27.29, 30.68, -25.66, 32.07}},
+	TC: {"Cockburn Town", Coordinates{21.46, -71.14}, Coordinates{21.58, -71.78}, BoundingBox{21.19, -72.48, 21.96, -71.08}},
+	TD: {"N'Djamena", Coordinates{12.13, 15.06}, Coordinates{15.33, 18.58}, BoundingBox{7.42, 13.54, 23.41, 23.89}},
+	TF: {"Port-aux-Français", Coordinates{-49.35, 70.22}, Coordinates{-49.31, 69.53}, BoundingBox{-49.77, 68.72, -48.62, 70.56}},
+	TG: {"Lomé", Coordinates{6.13, 1.22}, Coordinates{8.44, 1}, BoundingBox{5.93, -0.05, 11.02, 1.87}},
+	TH: {"Bangkok", Coordinates{13.76, 100.5}, Coordinates{15.02, 101.01}, BoundingBox{5.69, 97.38, 20.42, 105.59}},
+	TJ: {"Dushanbe", Coordinates{38.56, 68.79}, Coordinates{38.58, 71.03}, BoundingBox{36.74, 67.44, 40.96, 74.98}},
+	TK: {"", Coordinates{}, Coordinates{-9.17, -171.81}, BoundingBox{-9.44, -172.52, -8.53, -171.18}},
+	TL: {"Dili", Coordinates{-8.56, 125.57}, Coordinates{-8.77, 125.97}, BoundingBox{-9.39, 124.97, -8.27, 127.34}},
+	TM: {"Ashgabat", Coordinates{37.96, 58.33}, Coordinates{39.09, 59.28}, BoundingBox{35.27, 52.5, 42.75, 66.55}},
+	TN: {"Tunis", Coordinates{36.81, 10.18}, Coordinates{34.17, 9.53}, BoundingBox{30.31, 7.52, 37.35, 11.49}},
+	TO: {"Nukuʻalofa", Coordinates{-21.14, -175.2}, Coordinates{-21.14, -175.2}, BoundingBox{-22.35, -176.22, -15.56, -173.7}},
+	TR: {"Ankara", Coordinates{39.93, 32.86}, Coordinates{39.07, 35.12}, BoundingBox{35.82, 26.04, 42.14, 44.79}},
+	TT: {"Port of Spain", Coordinates{10.66, -61.51}, Coordinates{10.43, -61.33}, BoundingBox{10, -61.95, 10.89, -60.9}},
+	TV: {"Funafuti", Coordinates{-8.52, 179.2}, Coordinates{-8.52, 179.2}, BoundingBox{-10.8, 176.06, -5.64, 179.87}},
+	TW: {"Taipei", Coordinates{25.03, 121.57}, Coordinates{23.74, 120.97}, BoundingBox{21.97, 120.11, 25.3, 121.95}},
+	TZ: {"Dodoma", Coordinates{-6.16, 35.75}, Coordinates{-6.26, 34.75}, BoundingBox{-11.72, 29.34, -0.95, 40.32}},
+	UA: {"Kyiv", Coordinates{50.45, 30.52}, Coordinates{48.97, 31.37}, BoundingBox{44.36, 22.09, 52.34, 40.08}},
+	UG: {"Kampala", Coordinates{0.35, 32.58}, Coordinates{1.3, 32.36}, BoundingBox{-1.44, 29.58, 4.25, 35.04}},
+	UM: {"", Coordinates{}, Coordinates{5.88, -162.08}, BoundingBox{-0.39, 166.6, 28.22, -74.99}},
+	US: {"Washington, D.C.", Coordinates{38.9, -77.04}, Coordinates{45.71, -112.6}, BoundingBox{18.92, -171.79, 71.36, -66.96}},
+	UY: {"Montevideo", Coordinates{-34.9, -56.16}, Coordinates{-32.78, -56}, BoundingBox{-34.95, -58.43, -30.11, -53.21}},
+	UZ: {"Tashkent", Coordinates{41.3, 69.24}, Coordinates{41.75, 63.2}, BoundingBox{37.14, 55.93, 45.59, 73.06}},
+	VA: {"Vatican City", Coordinates{41.9, 12.45}, Coordinates{41.91, 12.46}, BoundingBox{41.9, 12.45, 41.91, 12.46}},
+	VC: {"Kingstown", Coordinates{13.16, -61.22}, Coordinates{12.98, -61.28}, BoundingBox{12.58, -61.46, 13.38, -61.11}},
+	VE: {"Caracas", Coordinates{10.48, -66.9}, Coordinates{7.16, -66.16}, BoundingBox{0.72, -73.3, 12.16, -59.76}},
+	VG: {"Road Town", Coordinates{18.43, -64.62}, Coordinates{18.56, -64.56}, BoundingBox{18.38, -64.85, 18.75, -64.27}},
+	VI: {"Charlotte Amalie", Coordinates{18.34, -64.93}, Coordinates{18.04, -64.83}, BoundingBox{17.67, -65.09, 18.41, -64.56}},
+	VN: {"Hanoi", Coordinates{21.03, 105.85}, Coordinates{16.66, 106.29}, BoundingBox{8.6, 102.17, 23.35, 109.34}},
+	VU: {"Port Vila", Coordinates{-17.73, 168.32}, Coordinates{-15.54, 167.07}, BoundingBox{-20.25, 166.52, -13.07, 170.24}},
+	WF: {"Mata-Utu", Coordinates{-13.28, -176.17}, Coordinates{-13.28, -176.17}, BoundingBox{-14.36, -178.19, -13.18, -176.12}},
+	WS: {"Apia", Coordinates{-13.83, -171.76}, Coordinates{-13.75, -172.1}, BoundingBox{-14.08, -172.8, -13.43, -171.4}},
+	YE: {"Sanaa", Coordinates{15.37, 44.19}, Coordinates{15.91, 47.54}, BoundingBox{12.59, 42.6, 19, 53.11}},
+	YT: {"Mamoudzou", Coordinates{-12.78, 45.23}, Coordinates{-12.82, 45.16}, BoundingBox{-13, 45.01, -12.64, 45.3}},
+	ZA: {"Pretoria", Coordinates{-25.75, 28.19}, Coordinates{-28.96, 25.12}, BoundingBox{-34.82, 16.34, -22.09, 32.83}},
+	ZM: {"Lusaka", Coordinates{-15.39, 28.32}, Coordinates{-13.4, 27.73}, BoundingBox{-17.96, 21.89, -8.24, 33.49}},
+	ZW: {"Harare", Coordinates{-17.83, 31.05}, Coordinates{-18.91, 29.79}, BoundingBox{-22.27, 25.26, -15.51, 32.85}},
+}
@@ -0,0 +1,158 @@
+package isocodes
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCoordinates_DistanceTo(t *testing.T) {
+	type tcase struct {
+		a, b Coordinates
+		want float64
+	}
+
+	tests := map[string]tcase{
+		"Same":          {Coordinates{52.52, 13.4}, Coordinates{52.52, 13.4}, 0},
+		"LondonParis":   {GB.CapitalCoordinates(), FR.CapitalCoordinates(), 343},
+		"BerlinTokyo":   {DE.CapitalCoordinates(), JP.CapitalCoordinates(), 8918},
+		"Antimeridian":  {Coordinates{0, 179.5}, Coordinates{0, -179.5}, 111},
+		"Antipodal":     {Coordinates{0, 0}, Coordinates{0, 180}, math.Pi * EarthRadius},
+		"PoleToEquator": {Coordinates{90, 0}, Coordinates{0, 45}, math.Pi * EarthRadius / 2},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := tc.a.DistanceTo(tc.b); math.Abs(got-tc.want) > 1 {
+				t.Errorf("DistanceTo() got = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestBoundingBox_Contains(t *testing.T) {
+	type tcase struct {
+		box  BoundingBox
+		p    Coordinates
+		want bool
+	}
+
+	tests := map[string]tcase{
+		"Inside":             {DE.BoundingBox(), Coordinates{50, 10}, true},
+		"Outside":            {DE.BoundingBox(), Coordinates{40, 10}, false},
+		"AntimeridianWest":   {RU.BoundingBox(), Coordinates{65, 175}, true},
+		"AntimeridianEast":   {RU.BoundingBox(), Coordinates{65, -172}, true},
+		"AntimeridianBeyond": {RU.BoundingBox(), Coordinates{65, -160}, false},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := tc.box.Contains(tc.p); got != tc.want {
+				t.Errorf("Contains() got = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCountryCode_Geography(t *testing.T) {
+	type tcase struct {
+		code    CountryCode
+		capital string
+	}
+
+	tests := map[string]tcase{
+		"DE":   {DE, "Berlin"},
+		"AU":   {AU, "Canberra"},
+		"GL":   {GL, "Nuuk"},
+		"AQ":   {AQ, ""},
+		"Zero": {UnknownCountry, ""},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := tc.code.Capital(); got != tc.capital {
+				t.Errorf("Capital() got = %v, want %v", got, tc.capital)
+			}
+
+			if got := tc.code.CapitalCoordinates().IsZero(); got != (tc.capital == "") {
+				t.Errorf("CapitalCoordinates().IsZero() got = %v, want %v", got, tc.capital == "")
+			}
+		})
+	}
+
+	t.Run("Consistency", func(t *testing.T) {
+		for _, c := range ListCountryCodes() {
+			g, ok := countryGeography[c]
+			if !ok {
+				t.Errorf("%v has no geography", c)
+
+				continue
+			}
+
+			box := g.bbox
+			if box.South > box.North || box.South < -90 || box.North > 90 || math.Abs(box.West) > 180 || math.Abs(box.East) > 180 {
+				t.Errorf("%v has invalid bounding box %+v", c, box)
+			}
+
+			if !box.Contains(g.centroid) && c != AQ && c != UM {
+				t.Errorf("%v centroid %+v is outside of bounding box %+v", c, g.centroid, box)
+			}
+
+			// Capitals are checked with a margin for simplified boundaries.
+			margin := BoundingBox{South: box.South - 0.3, West: box.West - 0.3, North: box.North + 0.3, East: box.East + 0.3}
+			if g.capital != "" && !margin.Contains(g.capitalAt) {
+				t.Errorf("%v capital %+v is outside of bounding box %+v", c, g.capitalAt, box)
+			}
+		}
+	})
+}
+
+func TestDistance(t *testing.T) {
+	type tcase struct {
+		a, b     CountryCode
+		min, max float64
+	}
+
+	tests := map[string]tcase{
+		"Same":      {DE, DE, 0, 0},
+		"Neighbors": {DE, FR, 500, 800},
+		"Far":       {DE, AU, 14000, 16000},
+		"Unknown":   {DE, UnknownCountry, 0, 0},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := Distance(tc.a, tc.b); got < tc.min || got > tc.max {
+				t.Errorf("Distance() got = %v, want between %v and %v", got, tc.min, tc.max)
+			}
+
+			if Distance(tc.a, tc.b) != Distance(tc.b, tc.a) {
+				t.Errorf("Distance() is not symmetric")
+			}
+		})
+	}
+}
+
+func TestNearest(t *testing.T) {
+	type tcase struct {
+		lat, lon float64
+		want     CountryCode
+	}
+
+	tests := map[string]tcase{
+		"Kassel":       {51.31, 9.48, DE},
+		"CentralSpain": {40, -4, ES},
+		"Kansas":       {39, -98, US},
+		"AliceSprings": {-23.7, 133.88, AU},
+		"Siberia":      {62, 100, RU},
+		"SouthPole":    {-89, 0, AQ},
+		"Fiji":         {-17.5, 179.9, FJ},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := Nearest(tc.lat, tc.lon); got != tc.want {
+				t.Errorf("Nearest() got = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,294 @@
+package isocodes
+
+import (
+	"math"
+	"sync"
+)
+
+//go:generate go run gen_boundaries.go -out boundaries_data.go -tags !isocodes_compact
+//go:generate go run gen_boundaries.go -tolerance 0.25 -out boundaries_compact_data.go -tags isocodes_compact
+
+// MaritimeDistance holds the maximum distance in kilometers from the coast
+// at which CountryAt falls back to the nearest country, which is
+// 200 nautical miles of the exclusive economic zone.
+const MaritimeDistance = 370.4
+
+// GeoMatch represents how CountryAt matched the country.
+type GeoMatch uint8
+
+const (
+	// GeoMatchNone - the coordinates don't belong to any country.
+	GeoMatchNone GeoMatch = iota
+
+	// GeoMatchLand - the coordinates are inside country boundaries.
+	GeoMatchLand
+
+	// GeoMatchMaritime - the coordinates are outside country boundaries,
+	// but within MaritimeDistance from the nearest country.
+	GeoMatchMaritime
+)
+
+func (m GeoMatch) String() string {
+	switch m {
+	case GeoMatchLand:
+		return "land"
+	case GeoMatchMaritime:
+		return "maritime"
+	default:
+		return "none"
+	}
+}
+
+// CountryAt returns the country at the coordinates using embedded simplified
+// country boundaries, so no external service is involved. Points at sea
+// fall back to the nearest country within MaritimeDistance.
+//
+// Boundaries are Natural Earth 1:110m, so points within a few kilometers
+// from borders and coasts may be attributed to a neighbour. Territories which
+// are too small for that scale, e.g. VA or SG, are matched by bounding boxes.
+// Programs built with the isocodes_compact tag embed boundaries simplified
+// with BoundariesTolerance, which are about half the size and less precise.
+//
+// Points inside Kosovo are attributed to XK, which is returned only if the code
+// is registered by RegisterCountryCode, otherwise it's the zero value with GeoMatchLand.
+func CountryAt(lat, lon float64) (CountryCode, GeoMatch) {
+	if math.IsNaN(lat) || math.IsNaN(lon) || math.IsInf(lat, 0) || math.IsInf(lon, 0) || lat < -90 || lat > 90 {
+		return UnknownCountry, GeoMatchNone
+	}
+
+	boundaryIndexOnce.Do(buildBoundaryIndex)
+
+	p := Coordinates{Lat: lat, Lon: normalizeLon(lon)}
+
+	for _, c := range boundaryIndex.tiny {
+		if countryGeography[c].bbox.Contains(p) {
+			return c, GeoMatchLand
+		}
+	}
+
+	for _, i := range boundaryIndex.cells[gridCell(p)] {
+		if boundaryIndex.bounds[i].Contains(p) && countryBoundaries[i].contains(p) {
+			return countryBoundaries[i].country(), GeoMatchLand
+		}
+	}
+
+	if c, ok := nearestCountry(p, MaritimeDistance); ok {
+		return c, GeoMatchMaritime
+	}
+
+	return UnknownCountry, GeoMatchNone
+}
+
+// boundary represents a polygon of the country with optional holes.
+type boundary struct {
+	code  string
+	rings [][]int32
+}
+
+// country returns the country of the boundary.
+func (b boundary) country() CountryCode {
+	c, _ := UnknownCountry.lookup(b.code)
+
+	return CountryCode(c)
+}
+
+// contains reports whether the point is inside the polygon by even-odd rule,
+// so points inside holes are outside.
+func (b boundary) contains(p Coordinates) bool {
+	x, y := p.Lon*100, p.Lat*100
+	inside := false
+
+	for _, r := range b.rings {
+		for i, j := 0, len(r)-2; i < len(r); j, i = i, i+2 {
+			xi, yi, xj, yj := float64(r[i]), float64(r[i+1]), float64(r[j]), float64(r[j+1])
+
+			if (yi > y) != (yj > y) && x < (xj-xi)*(y-yi)/(yj-yi)+xi {
+				inside = !inside
+			}
+		}
+	}
+
+	return inside
+}
+
+// distanceTo returns approximate distance in kilometers from the point
+// to the nearest edge of the polygon using the local equirectangular projection.
+func (b boundary) distanceTo(p Coordinates) float64 {
+	const km = EarthRadius * math.Pi / 180 / 100
+
+	scale := math.Cos(radians(p.Lat))
+	minimum := math.Inf(1)
+
+	project := func(lon, lat int32) (float64, float64) {
+		dLon := float64(lon) - p.Lon*100
+		if dLon > 18000 {
+			dLon -= 36000
+		} else if dLon < -18000 {
+			dLon += 36000
+		}
+
+		return dLon * scale * km, (float64(lat) - p.Lat*100) * km
+	}
+
+	for _, r := range b.rings {
+		for i := 2; i < len(r); i += 2 {
+			ax, ay := project(r[i-2], r[i-1])
+			bx, by := project(r[i], r[i+1])
+			dx, dy := bx-ax, by-ay
+
+			t := 0.0
+			if l := dx*dx + dy*dy; l > 0 {
+				t = math.Max(0, math.Min(1, -(ax*dx+ay*dy)/l))
+			}
+
+			minimum = math.Min(minimum, math.Hypot(ax+t*dx, ay+t*dy))
+		}
+	}
+
+	return minimum
+}
+
+// nearestCountry returns the country with the nearest boundary
+// or bounding box of the territory without boundaries within the distance.
+func nearestCountry(p Coordinates, within float64) (CountryCode, bool) {
+	var (
+		nearest CountryCode
+		found   bool
+	)
+
+	for i, b := range countryBoundaries {
+		if boundaryIndex.bounds[i].distanceTo(p) > within {
+			continue
+		}
+
+		c := b.country()
+		if c.IsZero() {
+			continue
+		}
+
+		if d := b.distanceTo(p); d <= within {
+			nearest, within, found = c, d, true
+		}
+	}
+
+	for _, c := range boundaryIndex.unbounded {
+		if d := countryGeography[c].bbox.distanceTo(p); d <= within {
+			nearest, within, found = c, d, true
+		}
+	}
+
+	return nearest, found
+}
+
+// distanceTo returns the distance in kilometers from the point to the nearest point of the box.
+func (b BoundingBox) distanceTo(p Coordinates) float64 {
+	nearest := Coordinates{Lat: math.Max(b.South, math.Min(b.North, p.Lat)), Lon: p.Lon}
+
+	if !b.Contains(Coordinates{Lat: nearest.Lat, Lon: p.Lon}) {
+		if lonDistance(p.Lon, b.West) < lonDistance(p.Lon, b.East) {
+			nearest.Lon = b.West
+		} else {
+			nearest.Lon = b.East
+		}
+	}
+
+	return p.DistanceTo(nearest)
+}
+
+// lonDistance returns the angular distance between longitudes in degrees.
+func lonDistance(a, b float64) float64 {
+	d := math.Abs(a - b)
+
+	return math.Min(d, 360-d)
+}
+
+// normalizeLon normalizes the longitude to [-180, 180).
+func normalizeLon(lon float64) float64 {
+	lon = math.Mod(lon+180, 360)
+	if lon < 0 {
+		lon += 360
+	}
+
+	return lon - 180
+}
+
+// gridSize holds the size of the boundary index cell in degrees.
+const gridSize = 5
+
+// gridCell returns the index of the grid cell containing the point.
+func gridCell(p Coordinates) int {
+	row := int(math.Min((p.Lat+90)/gridSize, 180/gridSize-1))
+	col := int(math.Min((p.Lon+180)/gridSize, 360/gridSize-1))
+
+	return row*(360/gridSize) + col
+}
+
+// tinyTerritorySpan holds the maximum span in degrees of the bounding box of
+// the territory without boundaries which is matched as land by its box.
+const tinyTerritorySpan = 2
+
+var (
+	// boundaryIndex holds the spatial index of countryBoundaries.
+	boundaryIndex struct {
+		// bounds holds bounding boxes of boundaries.
+		bounds []BoundingBox
+
+		// cells holds indexes of boundaries which intersect the grid cell.
+		cells [][]int
+
+		// tiny holds territories without boundaries which are matched by bounding boxes.
+		tiny []CountryCode
+
+		// unbounded holds all territories without boundaries.
+		unbounded []CountryCode
+	}
+	boundaryIndexOnce sync.Once
+)
+
+// buildBoundaryIndex builds the grid index of boundaries and
+// finds territories which are too small to have boundaries.
+func buildBoundaryIndex() {
+	boundaryIndex.bounds = make([]BoundingBox, len(countryBoundaries))
+	boundaryIndex.cells = make([][]int, (180/gridSize)*(360/gridSize))
+
+	bounded := make(map[string]struct{})
+
+	for i, b := range countryBoundaries {
+		bounded[b.code] = struct{}{}
+
+		box := BoundingBox{South: 90, West: 180, North: -90, East: -180}
+
+		for _, r := range b.rings {
+			for j := 0; j < len(r); j += 2 {
+				lon, lat := float64(r[j])/100, float64(r[j+1])/100
+				box.West, box.East = math.Min(box.West, lon), math.Max(box.East, lon)
+				box.South, box.North = math.Min(box.South, lat), math.Max(box.North, lat)
+			}
+		}
+
+		boundaryIndex.bounds[i] = box
+
+		for lat := box.South; lat < box.North+gridSize; lat += gridSize {
+			for lon := box.West; lon < box.East+gridSize; lon += gridSize {
+				cell := gridCell(Coordinates{Lat: math.Min(lat, box.North), Lon: math.Min(lon, box.East)})
+
+				if n := len(boundaryIndex.cells[cell]); n == 0 || boundaryIndex.cells[cell][n-1] != i {
+					boundaryIndex.cells[cell] = append(boundaryIndex.cells[cell], i)
+				}
+			}
+		}
+	}
+
+	for _, c := range ListCountryCodes() {
+		g, ok := countryGeography[c]
+		if _, has := bounded[c.String()]; has || !ok {
+			continue
+		}
+
+		boundaryIndex.unbounded = append(boundaryIndex.unbounded, c)
+
+		if g.bbox.North-g.bbox.South <= tinyTerritorySpan && lonDistance(g.bbox.West, g.bbox.East) <= tinyTerritorySpan {
+			boundaryIndex.tiny = append(boundaryIndex.tiny, c)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package isocodes
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCountryAt(t *testing.T) {
+	type tcase struct {
+		lat, lon  float64
+		want      CountryCode
+		wantMatch GeoMatch
+	}
+
+	tests := map[string]tcase{
+		"Berlin":         {52.52, 13.4, DE, GeoMatchLand},
+		"Paris":          {48.86, 2.35, FR, GeoMatchLand},
+		"Madrid":         {40.42, -3.7, ES, GeoMatchLand},
+		"Kyiv":           {50.45, 30.52, UA, GeoMatchLand},
+		"Moscow":         {55.76, 37.62, RU, GeoMatchLand},
+		"Chukotka":       {66, -172, RU, GeoMatchLand},
+		"Denver":         {39.74, -104.99, US, GeoMatchLand},
+		"Fairbanks":      {64.84, -147.72, US, GeoMatchLand},
+		"Winnipeg":       {49.9, -97.14, CA, GeoMatchLand},
+		"SaoPaulo":       {-23.55, -46.63, BR, GeoMatchLand},
+		"Beijing":        {39.9, 116.41, CN, GeoMatchLand},
+		"Delhi":          {28.61, 77.21, IN, GeoMatchLand},
+		"Nairobi":        {-1.29, 36.82, KE, GeoMatchLand},
+		"Cairo":          {30.04, 31.24, EG, GeoMatchLand},
+		"AliceSprings":   {-23.7, 133.88, AU, GeoMatchLand},
+		"Lesotho":        {-29.6, 28.2, LS, GeoMatchLand},
+		"Vatican":        {41.902, 12.453, VA, GeoMatchLand},
+		"SanMarino":      {43.94, 12.45, SM, GeoMatchLand},
+		"Monaco":         {43.735, 7.42, MC, GeoMatchLand},
+		"Singapore":      {1.35, 103.82, SG, GeoMatchLand},
+		"FrenchGuiana":   {4, -53, GF, GeoMatchLand},
+		"Svalbard":       {78.5, 17, SJ, GeoMatchLand},
+		"NorthernCyprus": {35.25, 33.6, CY, GeoMatchLand},
+		"Somaliland":     {9.56, 44.06, SO, GeoMatchLand},
+		"OffPortugal":    {38.7, -10, PT, GeoMatchMaritime},
+		"OffMalta":       {35.5, 14.8, MT, GeoMatchMaritime},
+		"MidAtlantic":    {30, -40, UnknownCountry, GeoMatchNone},
+		"LongitudeWrap":  {52.52, 373.4, DE, GeoMatchLand},
+		"InvalidLat":     {91, 0, UnknownCountry, GeoMatchNone},
+		"NaN":            {math.NaN(), 0, UnknownCountry, GeoMatchNone},
+		"PosInfLon":      {0, math.Inf(1), UnknownCountry, GeoMatchNone},
+		"NegInfLon":      {0, math.Inf(-1), UnknownCountry, GeoMatchNone},
+		"PosInfLat":      {math.Inf(1), 0, UnknownCountry, GeoMatchNone},
+		"NegInfLat":      {math.Inf(-1), 0, UnknownCountry, GeoMatchNone},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, match := CountryAt(tc.lat, tc.lon)
+			if got != tc.want || match != tc.wantMatch {
+				t.Errorf("CountryAt() got = %v, %v, want %v, %v", got, match, tc.want, tc.wantMatch)
+			}
+		})
+	}
+
+	t.Run("Capitals", func(t *testing.T) {
+		var correct, total int
+
+		for c, g := range countryGeography {
+			if g.capital == "" {
+				continue
+			}
+
+			total++
+
+			if got, _ := CountryAt(g.capitalAt.Lat, g.capitalAt.Lon); got == c {
+				correct++
+			}
+		}
+
+		// Coastal capitals may fall outside of simplified boundaries,
+		// but most of them must still be attributed correctly.
+		if accuracy := float64(correct) / float64(total); accuracy < 0.9 {
+			t.Errorf("CountryAt() accuracy on capitals got = %.2f, want at least 0.9", accuracy)
+		}
+	})
+}
+
+func TestCountryAt_Kosovo(t *testing.T) {
+	resetRegistries(t)
+
+	if got, match := CountryAt(42.66, 21.16); got != UnknownCountry || match != GeoMatchLand {
+		t.Errorf("CountryAt() got = %v, %v, want %v, %v", got, match, UnknownCountry, GeoMatchLand)
+	}
+
+	xk, err := RegisterCountryCode(CountryCodeDetails{Alpha2: "XK", Alpha3: "XKX", Name: "Kosovo"})
+	if err != nil {
+		t.Fatalf("RegisterCountryCode() error = %v", err)
+	}
+
+	if got, match := CountryAt(42.66, 21.16); got != xk || match != GeoMatchLand {
+		t.Errorf("CountryAt() got = %v, %v, want %v, %v", got, match, xk, GeoMatchLand)
+	}
+}
+
+func TestGeoMatch_String(t *testing.T) {
+	tests := map[GeoMatch]string{GeoMatchNone: "none", GeoMatchLand: "land", GeoMatchMaritime: "maritime"}
+
+	for m, want := range tests {
+		if got := m.String(); got != want {
+			t.Errorf("String() got = %v, want %v", got, want)
+		}
+	}
+}
+
+func BenchmarkCountryAt(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		CountryAt(52.52, 13.4)
+	}
+}
@@ -0,0 +1,375 @@
+package isocodes
+
+import (
+	"encoding/json"
+	"sort"
+	"time"
+)
+
+// Group represents an international organization, union or
+// agreement which countries are members of, e.g. the European Union.
+type Group string
+
+const (
+	// GroupEU represents the European Union.
+	GroupEU Group = "EU"
+
+	// GroupEEA represents the European Economic Area.
+	GroupEEA Group = "EEA"
+
+	// GroupSchengen represents the Schengen Area.
+	GroupSchengen Group = "Schengen"
+
+	// GroupEurozone represents countries of the European Union which use the euro.
+	GroupEurozone Group = "Eurozone"
+
+	// GroupOECD represents the Organisation for Economic Co-operation and Development.
+	GroupOECD Group = "OECD"
+
+	// GroupG7 represents the Group of Seven.
+	GroupG7 Group = "G7"
+
+	// GroupG20 represents the Group of Twenty. Only sovereign
+	// members are listed, the EU and the African Union are not.
+	GroupG20 Group = "G20"
+
+	// GroupASEAN represents the Association of Southeast Asian Nations.
+	GroupASEAN Group = "ASEAN"
+
+	// GroupMercosur represents the Southern Common Market.
+	GroupMercosur Group = "Mercosur"
+
+	// GroupGCC represents the Gulf Cooperation Council.
+	GroupGCC Group = "GCC"
+
+	// GroupCommonwealth represents the Commonwealth of Nations.
+	GroupCommonwealth Group = "Commonwealth"
+
+	// GroupNATO represents the North Atlantic Treaty Organization.
+	GroupNATO Group = "NATO"
+)
+
+// GroupMembership represents a period of membership of the country in the group.
+type GroupMembership struct {
+	Country CountryCode `json:"country"`
+
+	// Joined holds the date when the membership became effective.
+	Joined time.Time `json:"joined"`
+
+	// Left holds the date when the country ceased to be a member.
+	// It is the zero time for current members, which is marshalled as JSON null.
+	Left time.Time `json:"left"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (m GroupMembership) MarshalJSON() ([]byte, error) {
+	type membership struct {
+		Country CountryCode `json:"country"`
+		Joined  time.Time   `json:"joined"`
+		Left    *time.Time  `json:"left"`
+	}
+
+	out := membership{Country: m.Country, Joined: m.Joined}
+	if !m.Left.IsZero() {
+		out.Left = &m.Left
+	}
+
+	return json.Marshal(out)
+}
+
+// ActiveAt reports whether the membership is effective at the time.
+func (m GroupMembership) ActiveAt(t time.Time) bool {
+	return !t.Before(m.Joined) && (m.Left.IsZero() || t.Before(m.Left))
+}
+
+// ListGroups returns all built-in groups sorted by string representation.
+func ListGroups() []Group {
+	groups := make([]Group, 0, len(groupsDetails))
+
+	for g := range groupsDetails {
+		groups = append(groups, g)
+	}
+
+	sort.Slice(groups, func(i, j int) bool { return groups[i] < groups[j] })
+
+	return groups
+}
+
+// String returns string representation of the group.
+func (g Group) String() string { return string(g) }
+
+// Name returns the full name of the group.
+func (g Group) Name() string { return groupsDetails[g].name }
+
+// IsValid reports whether the group is a built-in group.
+func (g Group) IsValid() bool {
+	_, ok := groupsDetails[g]
+
+	return ok
+}
+
+// Memberships returns the membership history of the group,
+// including former members, in the order of joining.
+func (g Group) Memberships() []GroupMembership {
+	memberships := groupsDetails[g].memberships
+	result := make([]GroupMembership, len(memberships))
+	copy(result, memberships)
+
+	sort.SliceStable(result, func(i, j int) bool { return result[i].Joined.Before(result[j].Joined) })
+
+	return result
+}
+
+// Countries returns current members of the group sorted by string representation.
+func (g Group) Countries() []CountryCode { return g.CountriesAt(time.Now()) }
+
+// CountriesAt returns members of the group at the time sorted by string representation.
+func (g Group) CountriesAt(t time.Time) []CountryCode {
+	var countries []CountryCode
+
+	for _, m := range groupsDetails[g].memberships {
+		if m.ActiveAt(t) {
+			countries = append(countries, m.Country)
+		}
+	}
+
+	sort.Slice(countries, func(i, j int) bool { return countries[i].String() < countries[j].String() })
+
+	return countries
+}
+
+// In reports whether the country is a current member of the group.
+func (c CountryCode) In(g Group) bool { return c.InAt(g, time.Now()) }
+
+// InAt reports whether the country was a member of the group at the time.
+func (c CountryCode) InAt(g Group, t time.Time) bool {
+	for _, m := range groupsDetails[g].memberships {
+		if m.Country == c && m.ActiveAt(t) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Groups returns built-in groups which the country
+// is currently a member of sorted by string representation.
+func (c CountryCode) Groups() []Group {
+	var groups []Group
+
+	for _, g := range ListGroups() {
+		if c.In(g) {
+			groups = append(groups, g)
+		}
+	}
+
+	return groups
+}
+
+// groupDetails represents details of the group.
+type groupDetails struct {
+	name        string
+	memberships []GroupMembership
+}
+
+// day returns the time of the date in UTC.
+func day(year int, month time.Month, d int) time.Time {
+	return time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+}
+
+// joined returns memberships of countries which joined the group at the date.
+func joined(date time.Time, countries ...CountryCode) []GroupMembership {
+	memberships := make([]GroupMembership, len(countries))
+
+	for i, c := range countries {
+		memberships[i] = GroupMembership{Country: c, Joined: date}
+	}
+
+	return memberships
+}
+
+// former returns membership of the country which left the group.
+func former(c CountryCode, joined, left time.Time) []GroupMembership {
+	return []GroupMembership{{Country: c, Joined: joined, Left: left}}
+}
+
+// memberships concatenates memberships of the group.
+func memberships(parts ...[]GroupMembership) []GroupMembership {
+	var result []GroupMembership
+
+	for _, p := range parts {
+		result = append(result, p...)
+	}
+
+	return result
+}
+
+// groupsDetails holds built-in groups with their membership history.
+var groupsDetails = map[Group]groupDetails{
+	GroupEU: {"European Union", memberships(
+		joined(day(1958, time.January, 1), BE, DE, FR, IT, LU, NL),
+		joined(day(1973, time.January, 1), DK, IE),
+		former(GB, day(1973, time.January, 1), day(2020, time.February, 1)),
+		joined(day(1981, time.January, 1), GR),
+		joined(day(1986, time.January, 1), ES, PT),
+		joined(day(1995, time.January, 1), AT, FI, SE),
+		joined(day(2004, time.May, 1), CY, CZ, EE, HU, LT, LV, MT, PL, SI, SK),
+		joined(day(2007, time.January, 1), BG, RO),
+		joined(day(2013, time.July, 1), HR),
+	)},
+	GroupEEA: {"European Economic Area", memberships(
+		joined(day(1994, time.January, 1), AT, BE, DE, DK, ES, FI, FR, GR, IE, IS, IT, LU, NL, NO, PT, SE),
+		former(GB, day(1994, time.January, 1), day(2020, time.February, 1)),
+		joined(day(1995, time.May, 1), LI),
+		joined(day(2004, time.May, 1), CY, CZ, EE, HU, LT, LV, MT, PL, SI, SK),
+		joined(day(2007, time.August, 1), BG, RO),
+		joined(day(2014, time.April, 12), HR),
+	)},
+	// Dates of the Schengen acquis implementation, BG and RO lifted air and sea
+	// border controls on 31 March 2024 and land border controls on 1 January 2025.
+	GroupSchengen: {"Schengen Area", memberships(
+		joined(day(1995, time.March, 26), BE, DE, ES, FR, LU, NL, PT),
+		joined(day(1997, time.October, 26), IT),
+		joined(day(1997, time.December, 1), AT),
+		joined(day(2000, time.March, 26), GR),
+		joined(day(2001, time.March, 25), DK, FI, IS, NO, SE),
+		joined(day(2007, time.December, 21), CZ, EE, HU, LT, LV, MT, PL, SI, SK),
+		joined(day(2008, time.December, 12), CH),
+		joined(day(2011, time.December, 19), LI),
+		joined(day(2023, time.January, 1), HR),
+		joined(day(2024, time.March, 31), BG, RO),
+	)},
+	GroupEurozone: {"Eurozone", memberships(
+		joined(day(1999, time.January, 1), AT, BE, DE, ES, FI, FR, IE, IT, LU, NL, PT),
+		joined(day(2001, time.January, 1), GR),
+		joined(day(2007, time.January, 1), SI),
+		joined(day(2008, time.January, 1), CY, MT),
+		joined(day(2009, time.January, 1), SK),
+		joined(day(2011, time.January, 1), EE),
+		joined(day(2014, time.January, 1), LV),
+		joined(day(2015, time.January, 1), LT),
+		joined(day(2023, time.January, 1), HR),
+		joined(day(2026, time.January, 1), BG),
+	)},
+	GroupOECD: {"Organisation for Economic Co-operation and Development", memberships(
+		joined(day(1961, time.September, 30), AT, BE, CA, CH, DE, DK, ES, FR, GB, GR, IE, IS, LU, NL, NO, PT, SE, TR, US),
+		joined(day(1962, time.March, 29), IT),
+		joined(day(1964, time.April, 28), JP),
+		joined(day(1969, time.January, 28), FI),
+		joined(day(1971, time.June, 7), AU),
+		joined(day(1973, time.May, 29), NZ),
+		joined(day(1994, time.May, 18), MX),
+		joined(day(1995, time.December, 21), CZ),
+		joined(day(1996, time.May, 7), HU),
+		joined(day(1996, time.November, 22), PL),
+		joined(day(1996, time.December, 12), KR),
+		joined(day(2000, time.December, 14), SK),
+		joined(day(2010, time.May, 7), CL),
+		joined(day(2010, time.July, 21), SI),
+		joined(day(2010, time.September, 7), IL),
+		joined(day(2010, time.December, 9), EE),
+		joined(day(2016, time.July, 1), LV),
+		joined(day(2018, time.July, 5), LT),
+		joined(day(2020, time.April, 28), CO),
+		joined(day(2021, time.May, 25), CR),
+	)},
+	GroupG7: {"Group of Seven", memberships(
+		joined(day(1975, time.November, 15), DE, FR, GB, IT, JP, US),
+		joined(day(1976, time.June, 27), CA),
+	)},
+	GroupG20: {"Group of Twenty", memberships(
+		joined(day(1999, time.September, 26), AR, AU, BR, CA, CN, DE, FR, GB, ID, IN, IT, JP, KR, MX, RU, SA, TR, US, ZA),
+	)},
+	GroupASEAN: {"Association of Southeast Asian Nations", memberships(
+		joined(day(1967, time.August, 8), ID, MY, PH, SG, TH),
+		joined(day(1984, time.January, 7), BN),
+		joined(day(1995, time.July, 28), VN),
+		joined(day(1997, time.July, 23), LA, MM),
+		joined(day(1999, time.April, 30), KH),
+		joined(day(2025, time.October, 26), TL),
+	)},
+	// Venezuela is suspended since 1 December 2016, which is modelled as leaving.
+	GroupMercosur: {"Southern Common Market", memberships(
+		joined(day(1991, time.March, 26), AR, BR, PY, UY),
+		former(VE, day(2012, time.July, 31), day(2016, time.December, 1)),
+		joined(day(2024, time.July, 8), BO),
+	)},
+	GroupGCC: {"Gulf Cooperation Council", memberships(
+		joined(day(1981, time.May, 25), AE, BH, KW, OM, QA, SA),
+	)},
+	// Dominions are members since the Statute of Westminster,
+	// other countries since their independence or admission.
+	GroupCommonwealth: {"Commonwealth of Nations", memberships(
+		joined(day(1931, time.December, 11), AU, CA, GB, NZ),
+		former(ZA, day(1931, time.December, 11), day(1961, time.May, 31)),
+		former(IE, day(1931, time.December, 11), day(1949, time.April, 18)),
+		joined(day(1947, time.August, 15), IN),
+		former(PK, day(1947, time.August, 14), day(1972, time.January, 30)),
+		joined(day(1948, time.February, 4), LK),
+		joined(day(1957, time.March, 6), GH),
+		joined(day(1957, time.August, 31), MY),
+		joined(day(1960, time.October, 1), NG),
+		joined(day(1961, time.March, 13), CY),
+		joined(day(1961, time.April, 27), SL),
+		joined(day(1961, time.December, 9), TZ),
+		joined(day(1962, time.August, 6), JM),
+		joined(day(1962, time.August, 31), TT),
+		joined(day(1962, time.October, 9), UG),
+		joined(day(1963, time.December, 12), KE),
+		joined(day(1964, time.July, 6), MW),
+		joined(day(1964, time.September, 21), MT),
+		joined(day(1964, time.October, 24), ZM),
+		former(GM, day(1965, time.February, 18), day(2013, time.October, 3)),
+		joined(day(1965, time.October, 15), SG),
+		joined(day(1966, time.May, 26), GY),
+		joined(day(1966, time.September, 30), BW),
+		joined(day(1966, time.October, 4), LS),
+		joined(day(1966, time.November, 30), BB),
+		joined(day(1968, time.March, 12), MU),
+		joined(day(1968, time.September, 6), SZ),
+		joined(day(1968, time.November, 1), NR),
+		joined(day(1970, time.June, 4), TO),
+		joined(day(1970, time.August, 28), WS),
+		former(FJ, day(1970, time.October, 10), day(1987, time.October, 15)),
+		joined(day(1972, time.April, 18), BD),
+		joined(day(1973, time.July, 10), BS),
+		joined(day(1974, time.February, 7), GD),
+		joined(day(1975, time.September, 16), PG),
+		joined(day(1976, time.June, 29), SC),
+		joined(day(1978, time.July, 7), SB),
+		joined(day(1978, time.October, 1), TV),
+		joined(day(1978, time.November, 3), DM),
+		joined(day(1979, time.February, 22), LC),
+		joined(day(1979, time.July, 12), KI),
+		joined(day(1979, time.October, 27), VC),
+		former(ZW, day(1980, time.April, 18), day(2003, time.December, 7)),
+		joined(day(1980, time.July, 30), VU),
+		joined(day(1981, time.September, 21), BZ),
+		joined(day(1981, time.November, 1), AG),
+		former(MV, day(1982, time.July, 9), day(2016, time.October, 13)),
+		joined(day(1983, time.September, 19), KN),
+		joined(day(1984, time.January, 1), BN),
+		joined(day(1989, time.October, 1), PK),
+		joined(day(1990, time.March, 21), NA),
+		joined(day(1994, time.June, 1), ZA),
+		joined(day(1995, time.November, 13), CM, MZ),
+		joined(day(1997, time.October, 1), FJ),
+		joined(day(2009, time.November, 29), RW),
+		joined(day(2018, time.February, 8), GM),
+		joined(day(2020, time.February, 1), MV),
+		joined(day(2022, time.June, 25), GA, TG),
+	)},
+	GroupNATO: {"North Atlantic Treaty Organization", memberships(
+		joined(day(1949, time.August, 24), BE, CA, DK, FR, GB, IS, IT, LU, NL, NO, PT, US),
+		joined(day(1952, time.February, 18), GR, TR),
+		joined(day(1955, time.May, 6), DE),
+		joined(day(1982, time.May, 30), ES),
+		joined(day(1999, time.March, 12), CZ, HU, PL),
+		joined(day(2004, time.March, 29), BG, EE, LT, LV, RO, SI, SK),
+		joined(day(2009, time.April, 1), AL, HR),
+		joined(day(2017, time.June, 5), ME),
+		joined(day(2020, time.March, 27), MK),
+		joined(day(2023, time.April, 4), FI),
+		joined(day(2024, time.March, 7), SE),
+	)},
+}
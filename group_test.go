@@ -0,0 +1,133 @@
+package isocodes
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGroup_CountriesAt(t *testing.T) {
+	type tcase struct {
+		group Group
+		at    time.Time
+		want  int
+	}
+
+	tests := map[string]tcase{
+		"EU1958":        {GroupEU, day(1958, time.January, 1), 6},
+		"EU2019":        {GroupEU, day(2019, time.January, 1), 28},
+		"EU2026":        {GroupEU, day(2026, time.January, 1), 27},
+		"EEA":           {GroupEEA, day(2025, time.January, 1), 30},
+		"Schengen":      {GroupSchengen, day(2025, time.June, 1), 29},
+		"Eurozone2025":  {GroupEurozone, day(2025, time.June, 1), 20},
+		"Eurozone2026":  {GroupEurozone, day(2026, time.June, 1), 21},
+		"OECD":          {GroupOECD, day(2025, time.January, 1), 38},
+		"G7":            {GroupG7, day(2025, time.January, 1), 7},
+		"G20":           {GroupG20, day(2025, time.January, 1), 19},
+		"ASEAN":         {GroupASEAN, day(2026, time.January, 1), 11},
+		"Mercosur":      {GroupMercosur, day(2025, time.January, 1), 5},
+		"GCC":           {GroupGCC, day(2025, time.January, 1), 6},
+		"Commonwealth":  {GroupCommonwealth, day(2025, time.January, 1), 56},
+		"NATO":          {GroupNATO, day(2025, time.January, 1), 32},
+		"BeforeFounded": {GroupNATO, day(1949, time.January, 1), 0},
+		"Unknown":       {Group("Unknown"), day(2025, time.January, 1), 0},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := tc.group.CountriesAt(tc.at); len(got) != tc.want {
+				t.Errorf("CountriesAt() got = %v countries %v, want %v", len(got), got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCountryCode_InAt(t *testing.T) {
+	type tcase struct {
+		country CountryCode
+		group   Group
+		at      time.Time
+		want    bool
+	}
+
+	tests := map[string]tcase{
+		"GBBeforeExit":       {GB, GroupEU, day(2020, time.January, 31), true},
+		"GBAfterExit":        {GB, GroupEU, day(2020, time.February, 1), false},
+		"FIBeforeNATO":       {FI, GroupNATO, day(2023, time.April, 3), false},
+		"FIAfterNATO":        {FI, GroupNATO, day(2023, time.April, 4), true},
+		"ZARejoined":         {ZA, GroupCommonwealth, day(2000, time.January, 1), true},
+		"ZAApartheid":        {ZA, GroupCommonwealth, day(1980, time.January, 1), false},
+		"VESuspended":        {VE, GroupMercosur, day(2020, time.January, 1), false},
+		"CHNotEU":            {CH, GroupEU, day(2020, time.January, 1), false},
+		"CHSchengen":         {CH, GroupSchengen, day(2020, time.January, 1), true},
+		"UnknownCountry":     {UnknownCountry, GroupEU, day(2020, time.January, 1), false},
+		"NotMemberOfUnknown": {DE, Group("Unknown"), day(2020, time.January, 1), false},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := tc.country.InAt(tc.group, tc.at); got != tc.want {
+				t.Errorf("InAt() got = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestGroups(t *testing.T) {
+	if !DE.In(GroupEU) || GB.In(GroupEU) || !GB.In(GroupNATO) {
+		t.Errorf("In() got DE in EU = %v, GB in EU = %v, GB in NATO = %v", DE.In(GroupEU), GB.In(GroupEU), GB.In(GroupNATO))
+	}
+
+	for _, g := range ListGroups() {
+		if !g.IsValid() || g.Name() == "" {
+			t.Errorf("%v IsValid() = %v, Name() = %v", g, g.IsValid(), g.Name())
+		}
+
+		seen := make(map[CountryCode]time.Time)
+
+		for _, m := range g.Memberships() {
+			if !m.Country.IsValid() {
+				t.Errorf("%v has invalid member %v", g, m.Country)
+			}
+
+			if !m.Left.IsZero() && !m.Left.After(m.Joined) {
+				t.Errorf("%v membership of %v left before joined", g, m.Country)
+			}
+
+			if left, ok := seen[m.Country]; ok && (left.IsZero() || m.Joined.Before(left)) {
+				t.Errorf("%v memberships of %v overlap", g, m.Country)
+			}
+
+			seen[m.Country] = m.Left
+		}
+	}
+
+	if got := len(ListGroups()); got != 12 {
+		t.Errorf("ListGroups() got = %v groups, want 12", got)
+	}
+}
+
+func TestGroupMembership_JSON(t *testing.T) {
+	in := GroupMembership{Country: DE, Joined: time.Date(1958, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	b, err := json.Marshal(in)
+	if err != nil {
+		t.Fatalf("json.Marshal() unexpected error = %v", err)
+	}
+
+	want := `{"country":"DE","joined":"1958-01-01T00:00:00Z","left":null}`
+	if string(b) != want {
+		t.Errorf("json.Marshal() got = %s, want %s", b, want)
+	}
+
+	var got GroupMembership
+	if err := json.Unmarshal(b, &got); err != nil || got != in || !got.Left.IsZero() {
+		t.Errorf("json.Unmarshal() got = %+v, %v, want %+v", got, err, in)
+	}
+	in.Left = time.Date(2020, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	if b, err := json.Marshal(in); err != nil || !strings.HasSuffix(string(b), `"left":"2020-01-31T00:00:00Z"}`) {
+		t.Errorf("json.Marshal() got = %s, %v, want left date", b, err)
+	}
+}
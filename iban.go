@@ -0,0 +1,453 @@
+package isocodes
+
+import (
+	"database/sql/driver"
+	"math/rand"
+	"sort"
+	"strings"
+)
+
+// IBAN represents ISO 13616 International Bank Account Number
+// in the electronic format, e.g. "DE89370400440532013000".
+// Use ParseIBAN to obtain a valid IBAN from the user input.
+type IBAN string
+
+// ParseIBAN takes IBAN in the electronic or the print format,
+// e.g. "DE89 3704 0044 0532 0130 00", validates its length and
+// BBAN structure against the IBAN registry of the country, verifies
+// its mod-97 check digits and returns IBAN in the electronic format.
+// Spaces are ignored and letters are case-insensitive.
+//
+// Invalid IBANs cause *ParseError which wraps ErrInvalidIBAN,
+// ErrUnsupportedIBANCountry or ErrInvalidIBANChecksum.
+func ParseIBAN(s string) (IBAN, error) {
+	iban := strings.ToUpper(strings.ReplaceAll(s, " ", ""))
+
+	if len(iban) < 4 || !isUpperLetters(iban[:2]) || !isDigits(iban[2:4]) {
+		return "", ibanParseError(s, ErrInvalidIBAN)
+	}
+
+	spec, ok := ibanRegistry[iban[:2]]
+	if !ok {
+		return "", ibanParseError(s, ErrUnsupportedIBANCountry)
+	}
+
+	if len(iban) != 4+spec.length() || !spec.matches(iban[4:]) {
+		return "", ibanParseError(s, ErrInvalidIBAN)
+	}
+
+	// Check digits are in the range from 02 to 98, the others pass
+	// the MOD 97-10 check when the valid check digits are 97, 98 or 02.
+	if check := iban[2:4]; check == "00" || check == "01" || check == "99" || mod97(iban[4:]+iban[:4]) != 1 {
+		return "", ibanParseError(s, ErrInvalidIBANChecksum)
+	}
+
+	return IBAN(iban), nil
+}
+
+// NewIBAN returns IBAN of the country with the BBAN and computed check digits.
+// The BBAN must match the BBAN structure of the country.
+func NewIBAN(country CountryCode, bban string) (IBAN, error) {
+	code := country.String()
+	bban = strings.ToUpper(strings.ReplaceAll(bban, " ", ""))
+
+	spec, ok := ibanRegistry[code]
+	if !ok {
+		return "", ibanParseError(code+bban, ErrUnsupportedIBANCountry)
+	}
+
+	if len(bban) != spec.length() || !spec.matches(bban) {
+		return "", ibanParseError(code+bban, ErrInvalidIBAN)
+	}
+
+	return IBAN(code + ibanCheckDigits(code, bban) + bban), nil
+}
+
+// GenerateIBAN returns a random valid IBAN of the country,
+// which is useful for testing. Generated IBANs pass all
+// the checks, but don't belong to existing bank accounts.
+// If r is nil, the default source of math/rand is used.
+func GenerateIBAN(country CountryCode, r *rand.Rand) (IBAN, error) {
+	spec, ok := ibanRegistry[country.String()]
+	if !ok {
+		return "", ibanParseError(country.String(), ErrUnsupportedIBANCountry)
+	}
+
+	intn := rand.Intn
+	if r != nil {
+		intn = r.Intn
+	}
+
+	var b strings.Builder
+
+	for _, seg := range spec.segments {
+		for i := 0; i < seg.length; i++ {
+			b.WriteByte(seg.charset[intn(len(seg.charset))])
+		}
+	}
+
+	return NewIBAN(country, b.String())
+}
+
+// IBANCountries returns countries which use IBAN sorted by string representation.
+func IBANCountries() []CountryCode {
+	countries := make([]CountryCode, 0, len(ibanRegistry))
+
+	for code := range ibanRegistry {
+		if c, ok := stringToCountryCode[code]; ok {
+			countries = append(countries, c)
+		}
+	}
+
+	sort.Slice(countries, func(i, j int) bool { return countries[i].String() < countries[j].String() })
+
+	return countries
+}
+
+// String returns IBAN in the electronic format.
+func (i IBAN) String() string { return string(i) }
+
+// PrintFormat returns IBAN in the print format, which is
+// the electronic format split into groups of four characters.
+func (i IBAN) PrintFormat() string {
+	var b strings.Builder
+
+	for n := 0; n < len(i); n += 4 {
+		if n > 0 {
+			b.WriteByte(' ')
+		}
+
+		b.WriteString(string(i[n:minInt(n+4, len(i))]))
+	}
+
+	return b.String()
+}
+
+// IsValid reports whether the IBAN is valid.
+func (i IBAN) IsValid() bool {
+	_, err := ParseIBAN(string(i))
+
+	return err == nil
+}
+
+// Country returns the country of the IBAN. For IBANs of the countries
+// which are not ISO 3166-1 codes, e.g. XK of Kosovo, it returns
+// UnknownCountry unless the code is registered by RegisterCountryCode.
+func (i IBAN) Country() CountryCode {
+	if len(i) < 2 {
+		return UnknownCountry
+	}
+
+	c, err := StringToCountryCode(string(i[:2]))
+	if err != nil {
+		return UnknownCountry
+	}
+
+	return c
+}
+
+// CheckDigits returns the mod-97 check digits of the IBAN.
+func (i IBAN) CheckDigits() string {
+	if len(i) < 4 {
+		return ""
+	}
+
+	return string(i[2:4])
+}
+
+// BBAN returns the Basic Bank Account Number part of the IBAN.
+func (i IBAN) BBAN() string {
+	if len(i) < 4 {
+		return ""
+	}
+
+	return string(i[4:])
+}
+
+// BankCode returns the national bank identifier of the IBAN.
+func (i IBAN) BankCode() string { return i.component(func(s ibanSpec) ibanRange { return s.bank }) }
+
+// BranchCode returns the branch identifier of the IBAN.
+// For countries without branch identifier it returns an empty string.
+func (i IBAN) BranchCode() string { return i.component(func(s ibanSpec) ibanRange { return s.branch }) }
+
+// AccountNumber returns the account number of the IBAN.
+// When the position of the account number isn't defined by the IBAN
+// registry, the part of the BBAN after the bank and branch identifiers is returned.
+func (i IBAN) AccountNumber() string {
+	return i.component(func(s ibanSpec) ibanRange {
+		if s.account.end > 0 {
+			return s.account
+		}
+
+		return ibanRange{start: maxInt(s.bank.end, s.branch.end), end: s.length()}
+	})
+}
+
+// MarshalJSON implements json.Marshaler.
+// The zero value is marshalled as JSON null.
+func (i IBAN) MarshalJSON() ([]byte, error) { return marshalIdentifierJSON(i) }
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It accepts IBAN in the electronic and the print format.
+func (i *IBAN) UnmarshalJSON(b []byte) error { return unmarshalIdentifierJSON(i, b, ParseIBAN) }
+
+// MarshalText implements encoding.TextMarshaler.
+// The zero value is marshalled as an empty text.
+func (i IBAN) MarshalText() ([]byte, error) { return marshalIdentifierText(i) }
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It accepts IBAN in the electronic and the print format.
+// An empty text is unmarshalled into the zero value.
+func (i *IBAN) UnmarshalText(b []byte) error { return parseIdentifier(i, string(b), ParseIBAN) }
+
+// Scan implements sql.Scanner.
+// SQL NULL and an empty string are scanned into the zero value.
+func (i *IBAN) Scan(src any) error { return scanIdentifierSQL(i, src, ParseIBAN) }
+
+// Value implements driver.Valuer.
+// The zero value is stored as SQL NULL.
+func (i IBAN) Value() (driver.Value, error) { return valueIdentifierSQL(i) }
+
+// component returns the part of the BBAN defined by the range.
+func (i IBAN) component(rangeOf func(s ibanSpec) ibanRange) string {
+	if len(i) < 4 {
+		return ""
+	}
+
+	spec, ok := ibanRegistry[string(i[:2])]
+	if !ok {
+		return ""
+	}
+
+	r := rangeOf(spec)
+	bban := string(i[4:])
+
+	if r.end == 0 || r.end > len(bban) {
+		return ""
+	}
+
+	return bban[r.start:r.end]
+}
+
+// ibanCheckDigits computes the mod-97 check digits of the IBAN.
+func ibanCheckDigits(country, bban string) string {
+	check := 98 - mod97(bban+country+"00")
+
+	return string([]byte{byte('0' + check/10), byte('0' + check%10)})
+}
+
+// mod97 returns the remainder of division by 97 of the number
+// which is obtained by replacing letters of s with two digits, A = 10 ... Z = 35.
+func mod97(s string) int {
+	remainder := 0
+
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c >= '0' && c <= '9':
+			remainder = (remainder*10 + int(c-'0')) % 97
+		case c >= 'A' && c <= 'Z':
+			remainder = (remainder*100 + int(c-'A') + 10) % 97
+		}
+	}
+
+	return remainder
+}
+
+// parseError returns ParseError for the invalid IBAN with the error.
+func (IBAN) parseError(input string, err error) *ParseError { return ibanParseError(input, err) }
+
+// ibanParseError returns ParseError for the invalid IBAN.
+func ibanParseError(input string, err error) *ParseError {
+	return &ParseError{Input: input, Type: CodeTypeIBAN, Format: FormatISO13616, Err: err}
+}
+
+// isDigits reports whether s consists of ASCII digits only.
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Character sets of the IBAN registry BBAN structure notation.
+const (
+	ibanDigits       = "0123456789"
+	ibanLetters      = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	ibanAlphanumeric = ibanDigits + ibanLetters
+)
+
+// ibanSegment represents a fixed length segment of the BBAN structure.
+type ibanSegment struct {
+	length  int
+	charset string
+}
+
+// ibanRange represents the position of the BBAN component.
+type ibanRange struct {
+	start int
+	end   int
+}
+
+// ibanSpec represents the IBAN registry entry of the country.
+type ibanSpec struct {
+	segments []ibanSegment
+	bank     ibanRange
+	branch   ibanRange
+	account  ibanRange
+}
+
+// length returns the length of the BBAN.
+func (s ibanSpec) length() int {
+	n := 0
+
+	for _, seg := range s.segments {
+		n += seg.length
+	}
+
+	return n
+}
+
+// matches reports whether the BBAN matches the structure.
+func (s ibanSpec) matches(bban string) bool {
+	n := 0
+
+	for _, seg := range s.segments {
+		for i := 0; i < seg.length; i++ {
+			if n >= len(bban) || strings.IndexByte(seg.charset, bban[n]) < 0 {
+				return false
+			}
+
+			n++
+		}
+	}
+
+	return n == len(bban)
+}
+
+// ibanStructure returns ibanSpec for the BBAN structure in the IBAN registry notation,
+// e.g. "8!n10!n", where n stands for digits, a for upper case letters
+// and c for alphanumeric characters.
+func ibanStructure(structure string, bank, branch, account ibanRange) ibanSpec {
+	spec := ibanSpec{bank: bank, branch: branch, account: account}
+	length := 0
+
+	for i := 0; i < len(structure); i++ {
+		switch c := structure[i]; c {
+		case '!':
+			continue
+		case 'n':
+			spec.segments = append(spec.segments, ibanSegment{length: length, charset: ibanDigits})
+			length = 0
+		case 'a':
+			spec.segments = append(spec.segments, ibanSegment{length: length, charset: ibanLetters})
+			length = 0
+		case 'c':
+			spec.segments = append(spec.segments, ibanSegment{length: length, charset: ibanAlphanumeric})
+			length = 0
+		default:
+			length = length*10 + int(c-'0')
+		}
+	}
+
+	return spec
+}
+
+// pos is a shorthand for ibanRange used in ibanRegistry.
+func pos(start, end int) ibanRange { return ibanRange{start: start, end: end} }
+
+// ibanRegistry holds BBAN structures of the SWIFT IBAN registry
+// keyed by the Alpha2 country code. Ranges of the bank, branch
+// and account components are positions within the BBAN.
+var ibanRegistry = map[string]ibanSpec{
+	"AD": ibanStructure("4!n4!n12!c", pos(0, 4), pos(4, 8), pos(8, 20)),
+	"AE": ibanStructure("3!n16!n", pos(0, 3), pos(0, 0), pos(3, 19)),
+	"AL": ibanStructure("8!n16!c", pos(0, 3), pos(3, 7), pos(8, 24)),
+	"AT": ibanStructure("5!n11!n", pos(0, 5), pos(0, 0), pos(5, 16)),
+	"AZ": ibanStructure("4!a20!c", pos(0, 4), pos(0, 0), pos(4, 24)),
+	"BA": ibanStructure("3!n3!n8!n2!n", pos(0, 3), pos(3, 6), pos(6, 14)),
+	"BE": ibanStructure("3!n7!n2!n", pos(0, 3), pos(0, 0), pos(3, 10)),
+	"BG": ibanStructure("4!a4!n2!n8!c", pos(0, 4), pos(4, 8), pos(10, 18)),
+	"BH": ibanStructure("4!a14!c", pos(0, 4), pos(0, 0), pos(4, 18)),
+	"BI": ibanStructure("5!n5!n11!n2!n", pos(0, 5), pos(5, 10), pos(10, 21)),
+	"BR": ibanStructure("8!n5!n10!n1!a1!c", pos(0, 8), pos(8, 13), pos(13, 23)),
+	"BY": ibanStructure("4!c4!n16!c", pos(0, 4), pos(0, 0), pos(8, 24)),
+	"CH": ibanStructure("5!n12!c", pos(0, 5), pos(0, 0), pos(5, 17)),
+	"CR": ibanStructure("4!n14!n", pos(0, 4), pos(0, 0), pos(4, 18)),
+	"CY": ibanStructure("3!n5!n16!c", pos(0, 3), pos(3, 8), pos(8, 24)),
+	"CZ": ibanStructure("4!n6!n10!n", pos(0, 4), pos(0, 0), pos(4, 20)),
+	"DE": ibanStructure("8!n10!n", pos(0, 8), pos(0, 0), pos(8, 18)),
+	"DJ": ibanStructure("5!n5!n11!n2!n", pos(0, 5), pos(5, 10), pos(10, 21)),
+	"DK": ibanStructure("4!n9!n1!n", pos(0, 4), pos(0, 0), pos(4, 14)),
+	"DO": ibanStructure("4!c20!n", pos(0, 4), pos(0, 0), pos(4, 24)),
+	"EE": ibanStructure("2!n2!n11!n1!n", pos(0, 2), pos(0, 0), pos(2, 16)),
+	"EG": ibanStructure("4!n4!n17!n", pos(0, 4), pos(4, 8), pos(8, 25)),
+	"ES": ibanStructure("4!n4!n1!n1!n10!n", pos(0, 4), pos(4, 8), pos(10, 20)),
+	"FI": ibanStructure("3!n11!n", pos(0, 3), pos(0, 0), pos(3, 14)),
+	"FK": ibanStructure("2!a12!n", pos(0, 2), pos(0, 0), pos(2, 14)),
+	"FO": ibanStructure("4!n9!n1!n", pos(0, 4), pos(0, 0), pos(4, 14)),
+	"FR": ibanStructure("5!n5!n11!c2!n", pos(0, 5), pos(5, 10), pos(10, 21)),
+	"GB": ibanStructure("4!a6!n8!n", pos(0, 4), pos(4, 10), pos(10, 18)),
+	"GE": ibanStructure("2!a16!n", pos(0, 2), pos(0, 0), pos(2, 18)),
+	"GI": ibanStructure("4!a15!c", pos(0, 4), pos(0, 0), pos(4, 19)),
+	"GL": ibanStructure("4!n9!n1!n", pos(0, 4), pos(0, 0), pos(4, 14)),
+	"GR": ibanStructure("3!n4!n16!c", pos(0, 3), pos(3, 7), pos(7, 23)),
+	"GT": ibanStructure("4!c20!c", pos(0, 4), pos(0, 0), pos(4, 24)),
+	"HR": ibanStructure("7!n10!n", pos(0, 7), pos(0, 0), pos(7, 17)),
+	"HU": ibanStructure("3!n4!n1!n15!n1!n", pos(0, 3), pos(3, 7), pos(8, 23)),
+	"IE": ibanStructure("4!a6!n8!n", pos(0, 4), pos(4, 10), pos(10, 18)),
+	"IL": ibanStructure("3!n3!n13!n", pos(0, 3), pos(3, 6), pos(6, 19)),
+	"IQ": ibanStructure("4!a3!n12!n", pos(0, 4), pos(4, 7), pos(7, 19)),
+	"IS": ibanStructure("4!n2!n6!n10!n", pos(0, 2), pos(2, 4), pos(6, 12)),
+	"IT": ibanStructure("1!a5!n5!n12!c", pos(1, 6), pos(6, 11), pos(11, 23)),
+	"JO": ibanStructure("4!a4!n18!c", pos(0, 4), pos(4, 8), pos(8, 26)),
+	"KW": ibanStructure("4!a22!c", pos(0, 4), pos(0, 0), pos(4, 26)),
+	"KZ": ibanStructure("3!n13!c", pos(0, 3), pos(0, 0), pos(3, 16)),
+	"LB": ibanStructure("4!n20!c", pos(0, 4), pos(0, 0), pos(4, 24)),
+	"LC": ibanStructure("4!a24!c", pos(0, 4), pos(0, 0), pos(4, 28)),
+	"LI": ibanStructure("5!n12!c", pos(0, 5), pos(0, 0), pos(5, 17)),
+	"LT": ibanStructure("5!n11!n", pos(0, 5), pos(0, 0), pos(5, 16)),
+	"LU": ibanStructure("3!n13!c", pos(0, 3), pos(0, 0), pos(3, 16)),
+	"LV": ibanStructure("4!a13!c", pos(0, 4), pos(0, 0), pos(4, 17)),
+	"LY": ibanStructure("3!n3!n15!n", pos(0, 3), pos(3, 6), pos(6, 21)),
+	"MC": ibanStructure("5!n5!n11!c2!n", pos(0, 5), pos(5, 10), pos(10, 21)),
+	"MD": ibanStructure("2!c18!c", pos(0, 2), pos(0, 0), pos(2, 20)),
+	"ME": ibanStructure("3!n13!n2!n", pos(0, 3), pos(0, 0), pos(3, 16)),
+	"MK": ibanStructure("3!n10!c2!n", pos(0, 3), pos(0, 0), pos(3, 13)),
+	"MN": ibanStructure("4!n12!n", pos(0, 4), pos(0, 0), pos(4, 16)),
+	"MR": ibanStructure("5!n5!n11!n2!n", pos(0, 5), pos(5, 10), pos(10, 21)),
+	"MT": ibanStructure("4!a5!n18!c", pos(0, 4), pos(4, 9), pos(9, 27)),
+	"MU": ibanStructure("4!a2!n2!n12!n3!n3!a", pos(0, 6), pos(6, 8), pos(8, 20)),
+	"NI": ibanStructure("4!a20!n", pos(0, 4), pos(0, 0), pos(4, 24)),
+	"NL": ibanStructure("4!a10!n", pos(0, 4), pos(0, 0), pos(4, 14)),
+	"NO": ibanStructure("4!n6!n1!n", pos(0, 4), pos(0, 0), pos(4, 10)),
+	"OM": ibanStructure("3!n16!c", pos(0, 3), pos(0, 0), pos(3, 19)),
+	"PK": ibanStructure("4!a16!c", pos(0, 4), pos(0, 0), pos(4, 20)),
+	"PL": ibanStructure("8!n16!n", pos(0, 8), pos(0, 0), pos(8, 24)),
+	"PS": ibanStructure("4!a21!c", pos(0, 4), pos(0, 0), pos(4, 25)),
+	"PT": ibanStructure("4!n4!n11!n2!n", pos(0, 4), pos(4, 8), pos(8, 19)),
+	"QA": ibanStructure("4!a21!c", pos(0, 4), pos(0, 0), pos(4, 25)),
+	"RO": ibanStructure("4!a16!c", pos(0, 4), pos(0, 0), pos(4, 20)),
+	"RS": ibanStructure("3!n13!n2!n", pos(0, 3), pos(0, 0), pos(3, 16)),
+	"RU": ibanStructure("9!n5!n15!c", pos(0, 9), pos(9, 14), pos(14, 29)),
+	"SA": ibanStructure("2!n18!c", pos(0, 2), pos(0, 0), pos(2, 20)),
+	"SC": ibanStructure("4!a2!n2!n16!n3!a", pos(0, 6), pos(6, 8), pos(8, 24)),
+	"SD": ibanStructure("2!n12!n", pos(0, 2), pos(0, 0), pos(2, 14)),
+	"SE": ibanStructure("3!n16!n1!n", pos(0, 3), pos(0, 0), pos(3, 20)),
+	"SI": ibanStructure("5!n8!n2!n", pos(0, 5), pos(0, 0), pos(5, 13)),
+	"SK": ibanStructure("4!n6!n10!n", pos(0, 4), pos(0, 0), pos(4, 20)),
+	"SM": ibanStructure("1!a5!n5!n12!c", pos(1, 6), pos(6, 11), pos(11, 23)),
+	"SO": ibanStructure("4!n3!n12!n", pos(0, 4), pos(4, 7), pos(7, 19)),
+	"ST": ibanStructure("8!n11!n2!n", pos(0, 4), pos(4, 8), pos(8, 19)),
+	"SV": ibanStructure("4!a20!n", pos(0, 4), pos(0, 0), pos(4, 24)),
+	"TL": ibanStructure("3!n14!n2!n", pos(0, 3), pos(0, 0), pos(3, 17)),
+	"TN": ibanStructure("2!n3!n13!n2!n", pos(0, 2), pos(2, 5), pos(5, 18)),
+	"TR": ibanStructure("5!n1!n16!c", pos(0, 5), pos(0, 0), pos(6, 22)),
+	"UA": ibanStructure("6!n19!c", pos(0, 6), pos(0, 0), pos(6, 25)),
+	"VA": ibanStructure("3!n15!n", pos(0, 3), pos(0, 0), pos(3, 18)),
+	"VG": ibanStructure("4!a16!n", pos(0, 4), pos(0, 0), pos(4, 20)),
+	"XK": ibanStructure("4!n10!n2!n", pos(0, 2), pos(2, 4), pos(4, 14)),
+}
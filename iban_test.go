@@ -0,0 +1,208 @@
+package isocodes
+
+import (
+	"encoding/json"
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+func TestParseIBAN(t *testing.T) {
+	type tcase struct {
+		input   string
+		want    IBAN
+		wantErr error
+	}
+
+	tests := map[string]tcase{
+		"DE":            {"DE89370400440532013000", "DE89370400440532013000", nil},
+		"GB":            {"GB29NWBK60161331926819", "GB29NWBK60161331926819", nil},
+		"FR":            {"FR1420041010050500013M02606", "FR1420041010050500013M02606", nil},
+		"MT":            {"MT84MALT011000012345MTLCAST001S", "MT84MALT011000012345MTLCAST001S", nil},
+		"NO":            {"NO9386011117947", "NO9386011117947", nil},
+		"XK":            {"XK051212012345678906", "XK051212012345678906", nil},
+		"PrintFormat":   {"DE89 3704 0044 0532 0130 00", "DE89370400440532013000", nil},
+		"LowerCase":     {"gb29 nwbk 6016 1331 9268 19", "GB29NWBK60161331926819", nil},
+		"Checksum":      {"DE88370400440532013000", "", ErrInvalidIBANChecksum},
+		"Checksum02":    {"GB02NWBK60161330000012", "GB02NWBK60161330000012", nil},
+		"Checksum97":    {"GB97NWBK60161330000048", "GB97NWBK60161330000048", nil},
+		"Checksum98":    {"GB98NWBK60161330000030", "GB98NWBK60161330000030", nil},
+		"Checksum00":    {"GB00NWBK60161330000048", "", ErrInvalidIBANChecksum},
+		"Checksum01":    {"GB01NWBK60161330000030", "", ErrInvalidIBANChecksum},
+		"Checksum99":    {"GB99NWBK60161330000012", "", ErrInvalidIBANChecksum},
+		"Length":        {"DE8937040044053201300", "", ErrInvalidIBAN},
+		"Structure":     {"GB29NWBK6016133192681A", "", ErrInvalidIBAN},
+		"Unsupported":   {"US12345678901234567890", "", ErrUnsupportedIBANCountry},
+		"Short":         {"DE8", "", ErrInvalidIBAN},
+		"InvalidDigits": {"DEXX370400440532013000", "", ErrInvalidIBAN},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseIBAN(tc.input)
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Errorf("ParseIBAN() error = %v, wantErr %v", err, tc.wantErr)
+				}
+
+				var parseErr *ParseError
+				if !errors.As(err, &parseErr) || parseErr.Type != CodeTypeIBAN {
+					t.Errorf("ParseIBAN() error = %#v, want *ParseError of IBAN", err)
+				}
+			} else {
+				if err != nil || got != tc.want {
+					t.Errorf("ParseIBAN() got = %v, %v, want %v", got, err, tc.want)
+				}
+			}
+		})
+	}
+}
+
+func TestIBAN_Components(t *testing.T) {
+	type tcase struct {
+		iban        IBAN
+		country     CountryCode
+		checkDigits string
+		bank        string
+		branch      string
+		account     string
+		print       string
+	}
+
+	tests := map[string]tcase{
+		"DE": {"DE89370400440532013000", DE, "89", "37040044", "", "0532013000", "DE89 3704 0044 0532 0130 00"},
+		"GB": {"GB29NWBK60161331926819", GB, "29", "NWBK", "601613", "31926819", "GB29 NWBK 6016 1331 9268 19"},
+		"IT": {"IT60X0542811101000000123456", IT, "60", "05428", "11101", "000000123456", "IT60 X054 2811 1010 0000 0123 456"},
+		"NL": {"NL91ABNA0417164300", NL, "91", "ABNA", "", "0417164300", "NL91 ABNA 0417 1643 00"},
+		"XK": {"XK051212012345678906", UnknownCountry, "05", "12", "12", "0123456789", "XK05 1212 0123 4567 8906"},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := tc.iban.Country(); got != tc.country {
+				t.Errorf("Country() got = %v, want %v", got, tc.country)
+			}
+
+			if got := tc.iban.CheckDigits(); got != tc.checkDigits {
+				t.Errorf("CheckDigits() got = %v, want %v", got, tc.checkDigits)
+			}
+
+			if got := tc.iban.BankCode(); got != tc.bank {
+				t.Errorf("BankCode() got = %v, want %v", got, tc.bank)
+			}
+
+			if got := tc.iban.BranchCode(); got != tc.branch {
+				t.Errorf("BranchCode() got = %v, want %v", got, tc.branch)
+			}
+
+			if got := tc.iban.AccountNumber(); got != tc.account {
+				t.Errorf("AccountNumber() got = %v, want %v", got, tc.account)
+			}
+
+			if got := tc.iban.PrintFormat(); got != tc.print {
+				t.Errorf("PrintFormat() got = %v, want %v", got, tc.print)
+			}
+		})
+	}
+}
+
+func TestNewIBAN(t *testing.T) {
+	got, err := NewIBAN(DE, "370400440532013000")
+	if err != nil || got != "DE89370400440532013000" {
+		t.Errorf("NewIBAN() got = %v, %v, want DE89370400440532013000", got, err)
+	}
+
+	if _, err := NewIBAN(DE, "37040044053201300"); !errors.Is(err, ErrInvalidIBAN) {
+		t.Errorf("NewIBAN() error = %v, wantErr %v", err, ErrInvalidIBAN)
+	}
+
+	if _, err := NewIBAN(US, "370400440532013000"); !errors.Is(err, ErrUnsupportedIBANCountry) {
+		t.Errorf("NewIBAN() error = %v, wantErr %v", err, ErrUnsupportedIBANCountry)
+	}
+}
+
+func TestGenerateIBAN(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	for _, c := range IBANCountries() {
+		for i := 0; i < 10; i++ {
+			iban, err := GenerateIBAN(c, r)
+			if err != nil {
+				t.Fatalf("GenerateIBAN(%v) error = %v", c, err)
+			}
+
+			if got, err := ParseIBAN(iban.PrintFormat()); err != nil || got != iban {
+				t.Errorf("ParseIBAN(%v) got = %v, %v, want %v", iban, got, err, iban)
+			}
+
+			if iban.Country() != c {
+				t.Errorf("Country() got = %v, want %v", iban.Country(), c)
+			}
+		}
+	}
+
+	if _, err := GenerateIBAN(US, r); !errors.Is(err, ErrUnsupportedIBANCountry) {
+		t.Errorf("GenerateIBAN() error = %v, wantErr %v", err, ErrUnsupportedIBANCountry)
+	}
+
+	if iban, err := GenerateIBAN(DE, nil); err != nil || !iban.IsValid() {
+		t.Errorf("GenerateIBAN() with nil source got = %v, %v", iban, err)
+	}
+}
+
+func TestIBAN_MarshalJSON(t *testing.T) {
+	type account struct {
+		IBAN IBAN `json:"iban"`
+	}
+
+	b, err := json.Marshal(account{IBAN: "DE89370400440532013000"})
+	if err != nil || string(b) != `{"iban":"DE89370400440532013000"}` {
+		t.Errorf("Marshal() got = %s, %v", b, err)
+	}
+
+	if b, err := json.Marshal(account{}); err != nil || string(b) != `{"iban":null}` {
+		t.Errorf("Marshal() got = %s, %v, want null", b, err)
+	}
+
+	if _, err := json.Marshal(account{IBAN: "DE00370400440532013000"}); !errors.Is(err, ErrMarshalJSON) {
+		t.Errorf("Marshal() error = %v, wantErr %v", err, ErrMarshalJSON)
+	}
+
+	var got account
+	if err := json.Unmarshal([]byte(`{"iban":"DE89 3704 0044 0532 0130 00"}`), &got); err != nil || got.IBAN != "DE89370400440532013000" {
+		t.Errorf("Unmarshal() got = %v, %v", got.IBAN, err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"iban":"DE00370400440532013000"}`), &got); !errors.Is(err, ErrInvalidIBANChecksum) {
+		t.Errorf("Unmarshal() error = %v, wantErr %v", err, ErrInvalidIBANChecksum)
+	}
+
+	var parseErr *ParseError
+	if err := got.IBAN.UnmarshalJSON(nil); !errors.As(err, &parseErr) || !errors.Is(err, ErrUnmarshalJSON) {
+		t.Errorf("UnmarshalJSON() error = %v, want *ParseError wrapping %v", err, ErrUnmarshalJSON)
+	}
+
+	if err := got.IBAN.UnmarshalJSON([]byte(`"XX00"`)); !errors.As(err, &parseErr) {
+		t.Errorf("UnmarshalJSON() error = %v, want *ParseError", err)
+	}
+}
+
+func TestIBAN_SQL(t *testing.T) {
+	var got IBAN
+
+	if err := got.Scan([]byte("GB29NWBK60161331926819")); err != nil || got != "GB29NWBK60161331926819" {
+		t.Errorf("Scan() got = %v, %v", got, err)
+	}
+
+	if v, err := got.Value(); err != nil || v != "GB29NWBK60161331926819" {
+		t.Errorf("Value() got = %v, %v", v, err)
+	}
+
+	if err := got.Scan(nil); err != nil || got != "" {
+		t.Errorf("Scan() got = %v, %v, want zero", got, err)
+	}
+
+	if v, err := got.Value(); err != nil || v != nil {
+		t.Errorf("Value() got = %v, %v, want nil", v, err)
+	}
+}
@@ -0,0 +1,188 @@
+package isocodes
+
+import (
+	"database/sql/driver"
+	"strings"
+)
+
+// ISIN represents ISO 6166 International Securities Identification Number,
+// e.g. "US0378331005". Use ParseISIN to obtain a valid ISIN from the user input.
+type ISIN string
+
+// Special ISIN prefixes which aren't ISO 3166-1 country codes.
+const (
+	// ISINPrefixInternational is used for securities issued through
+	// the international central securities depositories, such as Eurobonds.
+	ISINPrefixInternational = "XS"
+
+	// ISINPrefixEU is used for securities issued by the European Union institutions.
+	ISINPrefixEU = "EU"
+)
+
+// ParseISIN takes case-insensitive ISIN, validates its structure,
+// the prefix and the check digit and returns the upper case ISIN.
+// The prefix must be ISO 3166-1 Alpha2 country code, XS or EU.
+//
+// Invalid ISINs cause *ParseError which wraps ErrInvalidISIN or ErrInvalidISINChecksum.
+func ParseISIN(s string) (ISIN, error) {
+	isin := strings.ToUpper(s)
+
+	if len(isin) != 12 || !isISINPrefix(isin[:2]) || !isAlphanumeric(isin[2:11]) || !isDigits(isin[11:]) {
+		return "", isinParseError(s, ErrInvalidISIN)
+	}
+
+	if isinCheckDigit(isin[:11]) != isin[11] {
+		return "", isinParseError(s, ErrInvalidISINChecksum)
+	}
+
+	return ISIN(isin), nil
+}
+
+// NewISIN returns ISIN with the prefix, the 9 characters national
+// securities identifying number (NSIN) and the computed check digit.
+// NSINs shorter than 9 characters are padded with leading zeros.
+func NewISIN(prefix, nsin string) (ISIN, error) {
+	prefix, nsin = strings.ToUpper(prefix), strings.ToUpper(nsin)
+
+	if len(nsin) < 9 {
+		nsin = strings.Repeat("0", 9-len(nsin)) + nsin
+	}
+
+	base := prefix + nsin
+
+	if len(base) != 11 || !isISINPrefix(prefix) || !isAlphanumeric(nsin) {
+		return "", isinParseError(base, ErrInvalidISIN)
+	}
+
+	return ISIN(base + string(isinCheckDigit(base))), nil
+}
+
+// String returns string representation of the ISIN.
+func (i ISIN) String() string { return string(i) }
+
+// IsValid reports whether the ISIN is valid.
+func (i ISIN) IsValid() bool {
+	_, err := ParseISIN(string(i))
+
+	return err == nil
+}
+
+// Prefix returns the 2 characters prefix of the ISIN.
+func (i ISIN) Prefix() string {
+	if len(i) < 2 {
+		return ""
+	}
+
+	return string(i[:2])
+}
+
+// Country returns the issuing country of the ISIN. For the XS and EU
+// prefixes it returns UnknownCountry, use IsInternational to detect them.
+func (i ISIN) Country() CountryCode {
+	c, ok := CountryCode(0).lookup(i.Prefix())
+	if !ok {
+		return UnknownCountry
+	}
+
+	return CountryCode(c)
+}
+
+// IsInternational reports whether the ISIN has XS or EU prefix.
+func (i ISIN) IsInternational() bool {
+	return i.Prefix() == ISINPrefixInternational || i.Prefix() == ISINPrefixEU
+}
+
+// NSIN returns the 9 characters national securities identifying number,
+// e.g. CUSIP for the US or SEDOL padded with zeros for the GB.
+func (i ISIN) NSIN() string {
+	if len(i) != 12 {
+		return ""
+	}
+
+	return string(i[2:11])
+}
+
+// CheckDigit returns the check digit of the ISIN.
+func (i ISIN) CheckDigit() string {
+	if len(i) != 12 {
+		return ""
+	}
+
+	return string(i[11:])
+}
+
+// MarshalJSON implements json.Marshaler.
+// The zero value is marshalled as JSON null.
+func (i ISIN) MarshalJSON() ([]byte, error) { return marshalIdentifierJSON(i) }
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (i *ISIN) UnmarshalJSON(b []byte) error { return unmarshalIdentifierJSON(i, b, ParseISIN) }
+
+// MarshalText implements encoding.TextMarshaler.
+// The zero value is marshalled as an empty text.
+func (i ISIN) MarshalText() ([]byte, error) { return marshalIdentifierText(i) }
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// An empty text is unmarshalled into the zero value.
+func (i *ISIN) UnmarshalText(b []byte) error { return parseIdentifier(i, string(b), ParseISIN) }
+
+// Scan implements sql.Scanner.
+// SQL NULL and an empty string are scanned into the zero value.
+func (i *ISIN) Scan(src any) error { return scanIdentifierSQL(i, src, ParseISIN) }
+
+// Value implements driver.Valuer.
+// The zero value is stored as SQL NULL.
+func (i ISIN) Value() (driver.Value, error) { return valueIdentifierSQL(i) }
+
+// isinCheckDigit computes the check digit of the first 11 characters of ISIN.
+// Letters are replaced with two digits, A = 10 ... Z = 35, and the
+// resulting digits are checked with the Luhn algorithm.
+func isinCheckDigit(base string) byte {
+	digits := make([]byte, 0, 2*len(base))
+
+	for i := 0; i < len(base); i++ {
+		if c := base[i]; c >= 'A' && c <= 'Z' {
+			n := int(c-'A') + 10
+			digits = append(digits, byte('0'+n/10), byte('0'+n%10))
+		} else {
+			digits = append(digits, c)
+		}
+	}
+
+	sum := 0
+
+	// The rightmost digit is doubled, because the check digit will follow it.
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+
+		if (len(digits)-1-i)%2 == 0 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+
+		sum += d
+	}
+
+	return byte('0' + (10-sum%10)%10)
+}
+
+// isISINPrefix reports whether s is a valid ISIN prefix.
+func isISINPrefix(s string) bool {
+	if s == ISINPrefixInternational || s == ISINPrefixEU {
+		return true
+	}
+
+	_, ok := CountryCode(0).lookup(s)
+
+	return ok
+}
+
+// parseError returns ParseError for the invalid ISIN with the error.
+func (ISIN) parseError(input string, err error) *ParseError { return isinParseError(input, err) }
+
+// isinParseError returns ParseError for the invalid ISIN.
+func isinParseError(input string, err error) *ParseError {
+	return &ParseError{Input: input, Type: CodeTypeISIN, Format: FormatISO6166, Err: err}
+}
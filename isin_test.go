@@ -0,0 +1,119 @@
+package isocodes
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestParseISIN(t *testing.T) {
+	type tcase struct {
+		input   string
+		want    ISIN
+		wantErr error
+	}
+
+	tests := map[string]tcase{
+		"US":            {"US0378331005", "US0378331005", nil},
+		"GB":            {"GB0002634946", "GB0002634946", nil},
+		"Letters":       {"AU0000XVGZA3", "AU0000XVGZA3", nil},
+		"International": {"XS2021832634", "XS2021832634", nil},
+		"LowerCase":     {"de000bay0017", "DE000BAY0017", nil},
+		"Checksum":      {"US0378331006", "", ErrInvalidISINChecksum},
+		"Length":        {"US037833100", "", ErrInvalidISIN},
+		"Prefix":        {"QQ0378331005", "", ErrInvalidISIN},
+		"CheckLetter":   {"US037833100A", "", ErrInvalidISIN},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseISIN(tc.input)
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Errorf("ParseISIN() error = %v, wantErr %v", err, tc.wantErr)
+				}
+			} else {
+				if err != nil || got != tc.want {
+					t.Errorf("ParseISIN() got = %v, %v, want %v", got, err, tc.want)
+				}
+			}
+		})
+	}
+}
+
+func TestNewISIN(t *testing.T) {
+	type tcase struct {
+		prefix  string
+		nsin    string
+		want    ISIN
+		wantErr error
+	}
+
+	tests := map[string]tcase{
+		"CUSIP":  {"US", "037833100", "US0378331005", nil},
+		"SEDOL":  {"gb", "0263494", "GB0002634946", nil},
+		"EU":     {"EU", "000A1G0D", "EU0000A1G0D1", nil},
+		"Prefix": {"QQ", "037833100", "", ErrInvalidISIN},
+		"Long":   {"US", "0378331000", "", ErrInvalidISIN},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := NewISIN(tc.prefix, tc.nsin)
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Errorf("NewISIN() error = %v, wantErr %v", err, tc.wantErr)
+				}
+
+				return
+			}
+
+			if err != nil || got != tc.want || !got.IsValid() {
+				t.Errorf("NewISIN() got = %v, %v, want %v", got, err, tc.want)
+			}
+		})
+	}
+}
+
+func TestISIN_Parts(t *testing.T) {
+	isin := ISIN("US0378331005")
+
+	if isin.Country() != US || isin.IsInternational() {
+		t.Errorf("Country() got = %v, IsInternational() = %v", isin.Country(), isin.IsInternational())
+	}
+
+	if isin.NSIN() != "037833100" || isin.CheckDigit() != "5" {
+		t.Errorf("NSIN() got = %v, CheckDigit() = %v", isin.NSIN(), isin.CheckDigit())
+	}
+
+	xs := ISIN("XS2021832634")
+
+	if xs.Country() != UnknownCountry || !xs.IsInternational() || xs.Prefix() != ISINPrefixInternational {
+		t.Errorf("Country() got = %v, IsInternational() = %v", xs.Country(), xs.IsInternational())
+	}
+}
+
+func TestISIN_Marshal(t *testing.T) {
+	type security struct {
+		ISIN ISIN `json:"isin"`
+	}
+
+	b, err := json.Marshal(security{ISIN: "US0378331005"})
+	if err != nil || string(b) != `{"isin":"US0378331005"}` {
+		t.Errorf("Marshal() got = %s, %v", b, err)
+	}
+
+	var got security
+	if err := json.Unmarshal([]byte(`{"isin":"US0378331006"}`), &got); !errors.Is(err, ErrInvalidISINChecksum) {
+		t.Errorf("Unmarshal() error = %v, wantErr %v", err, ErrInvalidISINChecksum)
+	}
+
+	var scanned ISIN
+	if err := scanned.Scan([]byte("GB0002634946")); err != nil || scanned != "GB0002634946" {
+		t.Errorf("Scan() got = %v, %v", scanned, err)
+	}
+
+	if v, err := scanned.Value(); err != nil || v != "GB0002634946" {
+		t.Errorf("Value() got = %v, %v", v, err)
+	}
+}
@@ -0,0 +1,111 @@
+package isocodes
+
+import (
+	"database/sql/driver"
+	"strings"
+)
+
+// LEI represents ISO 17442 Legal Entity Identifier, e.g. "5493001KJTIIGC8Y1R12".
+// Use ParseLEI to obtain a valid LEI from the user input.
+type LEI string
+
+// ParseLEI takes case-insensitive LEI, validates its structure
+// and the mod-97 check digits and returns the upper case LEI.
+//
+// Invalid LEIs cause *ParseError which wraps ErrInvalidLEI or ErrInvalidLEIChecksum.
+func ParseLEI(s string) (LEI, error) {
+	lei := strings.ToUpper(s)
+
+	if len(lei) != 20 || !isAlphanumeric(lei[:18]) || !isDigits(lei[18:]) {
+		return "", leiParseError(s, ErrInvalidLEI)
+	}
+
+	if mod97(lei) != 1 {
+		return "", leiParseError(s, ErrInvalidLEIChecksum)
+	}
+
+	return LEI(lei), nil
+}
+
+// NewLEI returns LEI with the 18 characters base, which consists of
+// the LOU prefix and the entity-specific part, and the computed check digits.
+func NewLEI(base string) (LEI, error) {
+	base = strings.ToUpper(base)
+
+	if len(base) != 18 || !isAlphanumeric(base) {
+		return "", leiParseError(base, ErrInvalidLEI)
+	}
+
+	check := 98 - mod97(base+"00")
+
+	return LEI(base + string([]byte{byte('0' + check/10), byte('0' + check%10)})), nil
+}
+
+// String returns string representation of the LEI.
+func (l LEI) String() string { return string(l) }
+
+// IsValid reports whether the LEI is valid.
+func (l LEI) IsValid() bool {
+	_, err := ParseLEI(string(l))
+
+	return err == nil
+}
+
+// LOU returns the 4 characters prefix of the Local Operating Unit
+// which issued the LEI.
+func (l LEI) LOU() string {
+	if len(l) != 20 {
+		return ""
+	}
+
+	return string(l[:4])
+}
+
+// EntityID returns the 14 characters entity-specific part of the LEI.
+func (l LEI) EntityID() string {
+	if len(l) != 20 {
+		return ""
+	}
+
+	return string(l[4:18])
+}
+
+// CheckDigits returns the mod-97 check digits of the LEI.
+func (l LEI) CheckDigits() string {
+	if len(l) != 20 {
+		return ""
+	}
+
+	return string(l[18:])
+}
+
+// MarshalJSON implements json.Marshaler.
+// The zero value is marshalled as JSON null.
+func (l LEI) MarshalJSON() ([]byte, error) { return marshalIdentifierJSON(l) }
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (l *LEI) UnmarshalJSON(b []byte) error { return unmarshalIdentifierJSON(l, b, ParseLEI) }
+
+// MarshalText implements encoding.TextMarshaler.
+// The zero value is marshalled as an empty text.
+func (l LEI) MarshalText() ([]byte, error) { return marshalIdentifierText(l) }
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// An empty text is unmarshalled into the zero value.
+func (l *LEI) UnmarshalText(b []byte) error { return parseIdentifier(l, string(b), ParseLEI) }
+
+// Scan implements sql.Scanner.
+// SQL NULL and an empty string are scanned into the zero value.
+func (l *LEI) Scan(src any) error { return scanIdentifierSQL(l, src, ParseLEI) }
+
+// Value implements driver.Valuer.
+// The zero value is stored as SQL NULL.
+func (l LEI) Value() (driver.Value, error) { return valueIdentifierSQL(l) }
+
+// parseError returns ParseError for the invalid LEI with the error.
+func (LEI) parseError(input string, err error) *ParseError { return leiParseError(input, err) }
+
+// leiParseError returns ParseError for the invalid LEI.
+func leiParseError(input string, err error) *ParseError {
+	return &ParseError{Input: input, Type: CodeTypeLEI, Format: FormatISO17442, Err: err}
+}
@@ -0,0 +1,77 @@
+package isocodes
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestParseLEI(t *testing.T) {
+	type tcase struct {
+		input   string
+		want    LEI
+		wantErr error
+	}
+
+	tests := map[string]tcase{
+		"Valid":       {"5493001KJTIIGC8Y1R12", "5493001KJTIIGC8Y1R12", nil},
+		"LowerCase":   {"7ltwfzyicnsx8d621k86", "7LTWFZYICNSX8D621K86", nil},
+		"Checksum":    {"5493001KJTIIGC8Y1R13", "", ErrInvalidLEIChecksum},
+		"Length":      {"5493001KJTIIGC8Y1R1", "", ErrInvalidLEI},
+		"CheckLetter": {"5493001KJTIIGC8Y1R1A", "", ErrInvalidLEI},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseLEI(tc.input)
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Errorf("ParseLEI() error = %v, wantErr %v", err, tc.wantErr)
+				}
+			} else {
+				if err != nil || got != tc.want {
+					t.Errorf("ParseLEI() got = %v, %v, want %v", got, err, tc.want)
+				}
+			}
+		})
+	}
+}
+
+func TestNewLEI(t *testing.T) {
+	got, err := NewLEI("5493001kjtiigc8y1r")
+	if err != nil || got != "5493001KJTIIGC8Y1R12" {
+		t.Errorf("NewLEI() got = %v, %v, want 5493001KJTIIGC8Y1R12", got, err)
+	}
+
+	if got.LOU() != "5493" || got.EntityID() != "001KJTIIGC8Y1R" || got.CheckDigits() != "12" {
+		t.Errorf("LOU() = %v, EntityID() = %v, CheckDigits() = %v", got.LOU(), got.EntityID(), got.CheckDigits())
+	}
+
+	if _, err := NewLEI("5493001KJTIIGC8Y1"); !errors.Is(err, ErrInvalidLEI) {
+		t.Errorf("NewLEI() error = %v, wantErr %v", err, ErrInvalidLEI)
+	}
+}
+
+func TestLEI_Marshal(t *testing.T) {
+	type entity struct {
+		LEI LEI `json:"lei"`
+	}
+
+	b, err := json.Marshal(entity{})
+	if err != nil || string(b) != `{"lei":null}` {
+		t.Errorf("Marshal() got = %s, %v, want null", b, err)
+	}
+
+	var got entity
+	if err := json.Unmarshal([]byte(`{"lei":"5493001KJTIIGC8Y1R12"}`), &got); err != nil || got.LEI != "5493001KJTIIGC8Y1R12" {
+		t.Errorf("Unmarshal() got = %v, %v", got.LEI, err)
+	}
+
+	if v, err := got.LEI.Value(); err != nil || v != "5493001KJTIIGC8Y1R12" {
+		t.Errorf("Value() got = %v, %v", v, err)
+	}
+
+	if _, err := LEI("5493001KJTIIGC8Y1R13").Value(); !errors.Is(err, ErrValueSQL) {
+		t.Errorf("Value() error = %v, wantErr %v", err, ErrValueSQL)
+	}
+}
@@ -0,0 +1,306 @@
+package isocodes
+
+import (
+	"database/sql/driver"
+	"sync/atomic"
+)
+
+// MarshalPolicy defines how invalid code values are handled
+// in the process of marshalling and unmarshalling
+// to and from JSON, text and SQL representations.
+//
+// The zero value of any code type is not affected by the policy:
+// it is always marshalled as JSON null, empty text or SQL NULL,
+// and those representations are always unmarshalled back into
+// the zero value.
+type MarshalPolicy int32
+
+const (
+	// MarshalStrict - invalid code values and unknown string
+	// representations cause an error. This is the default policy.
+	MarshalStrict MarshalPolicy = iota
+
+	// MarshalLenient - invalid code values are marshalled as if they were
+	// the zero value and unknown string representations are unmarshalled
+	// into the zero value without an error.
+	MarshalLenient
+)
+
+// marshalPolicy holds the package wide MarshalPolicy.
+var marshalPolicy atomic.Int32
+
+// SetMarshalPolicy sets the package wide MarshalPolicy.
+// It is safe to call concurrently, but the policy is expected
+// to be configured once at the application startup.
+func SetMarshalPolicy(p MarshalPolicy) { marshalPolicy.Store(int32(p)) }
+
+// CurrentMarshalPolicy returns the package wide MarshalPolicy.
+func CurrentMarshalPolicy() MarshalPolicy { return MarshalPolicy(marshalPolicy.Load()) }
+
+// isLenient reports whether the MarshalLenient policy is set.
+func isLenient() bool { return CurrentMarshalPolicy() == MarshalLenient }
+
+// jsonNull represents JSON null literal.
+const jsonNull = "null"
+
+// unquote removes surrounding double quotes from the JSON string.
+// For the backward compatibility unquoted input is returned as is.
+func unquote(b []byte) string {
+	if len(b) >= 2 && b[0] == '"' && b[len(b)-1] == '"' {
+		return string(b[1 : len(b)-1])
+	}
+
+	return string(b)
+}
+
+// sqlString converts the value received by sql.Scanner into a string.
+func sqlString(src any) (string, error) {
+	switch v := src.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	case []byte:
+		return string(v), nil
+	default:
+		return "", ErrScanSQL
+	}
+}
+
+// unmarshalJSON sets the code from its JSON representation
+// according to the current MarshalPolicy.
+func unmarshalJSON[T Code](c *T, b []byte) error {
+	if len(b) == 0 {
+		return (*c).parseError("", ErrUnmarshalJSON)
+	}
+
+	if string(b) == jsonNull {
+		*c = 0
+
+		return nil
+	}
+
+	return parseCode(c, unquote(b), ErrUnmarshalJSON)
+}
+
+// marshalJSON returns JSON representation of the code
+// according to the current MarshalPolicy.
+func marshalJSON[T Code](c T) ([]byte, error) {
+	code, err := formatCode(c, ErrMarshalJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	if code == "" {
+		return []byte(jsonNull), nil
+	}
+
+	return []byte(`"` + code + `"`), nil
+}
+
+// marshalText returns text representation of the code according to
+// the current MarshalPolicy. Codes are quoted like in JSON, but the zero
+// value is marshalled as an empty text, so it is unmarshalled back.
+func marshalText[T Code](c T) ([]byte, error) {
+	code, err := formatCode(c, ErrMarshalText)
+	if err != nil {
+		return nil, err
+	}
+
+	if code == "" {
+		return []byte{}, nil
+	}
+
+	return []byte(`"` + code + `"`), nil
+}
+
+// scanSQL sets the code from the value received by sql.Scanner
+// according to the current MarshalPolicy.
+func scanSQL[T Code](c *T, src any) error {
+	s, err := sqlString(src)
+	if err != nil {
+		return err
+	}
+
+	return parseCode(c, s, ErrScanSQL)
+}
+
+// valueSQL returns sql value of the code
+// according to the current MarshalPolicy.
+func valueSQL[T Code](c T) (driver.Value, error) {
+	code, err := formatCode(c, ErrValueSQL)
+	if err != nil {
+		return nil, err
+	}
+
+	if code == "" {
+		return nil, nil
+	}
+
+	return code, nil
+}
+
+// parseCode sets the code from its exact string representation
+// according to the current MarshalPolicy.
+func parseCode[T Code](c *T, s string, errInvalid error) error {
+	if s == "" {
+		*c = 0
+
+		return nil
+	}
+
+	code, ok := (*c).lookup(s)
+	if !ok {
+		if isLenient() {
+			*c = 0
+
+			return nil
+		}
+
+		return (*c).parseError(s, errInvalid)
+	}
+
+	*c = T(code)
+
+	return nil
+}
+
+// formatCode returns string representation of the code
+// according to the current MarshalPolicy.
+// For the zero value it returns an empty string.
+func formatCode[T Code](c T, errInvalid error) (string, error) {
+	if c.IsZero() {
+		return "", nil
+	}
+
+	if !c.IsValid() {
+		if isLenient() {
+			return "", nil
+		}
+
+		return "", errInvalid
+	}
+
+	return c.String(), nil
+}
+
+// identifier represents a string based identifier type of the package,
+// such as IBAN and BIC. Unlike Code, identifiers aren't enumerated
+// and are validated by their parse functions.
+type identifier interface {
+	~string
+	IsValid() bool
+	parseError(input string, err error) *ParseError
+}
+
+// marshalIdentifierJSON returns JSON representation of the identifier
+// according to the current MarshalPolicy.
+func marshalIdentifierJSON[T identifier](id T) ([]byte, error) {
+	s, err := formatIdentifier(id, ErrMarshalJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	if s == "" {
+		return []byte(jsonNull), nil
+	}
+
+	return []byte(`"` + s + `"`), nil
+}
+
+// unmarshalIdentifierJSON sets the identifier from its JSON representation
+// according to the current MarshalPolicy.
+func unmarshalIdentifierJSON[T identifier](id *T, b []byte, parse func(string) (T, error)) error {
+	if len(b) == 0 {
+		return (*id).parseError("", ErrUnmarshalJSON)
+	}
+
+	if string(b) == jsonNull {
+		*id = ""
+
+		return nil
+	}
+
+	return parseIdentifier(id, unquote(b), parse)
+}
+
+// marshalIdentifierText returns text representation of the identifier
+// according to the current MarshalPolicy.
+func marshalIdentifierText[T identifier](id T) ([]byte, error) {
+	s, err := formatIdentifier(id, ErrMarshalText)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(s), nil
+}
+
+// scanIdentifierSQL sets the identifier from the value received
+// by sql.Scanner according to the current MarshalPolicy.
+func scanIdentifierSQL[T identifier](id *T, src any, parse func(string) (T, error)) error {
+	s, err := sqlString(src)
+	if err != nil {
+		return err
+	}
+
+	return parseIdentifier(id, s, parse)
+}
+
+// valueIdentifierSQL returns sql value of the identifier
+// according to the current MarshalPolicy.
+func valueIdentifierSQL[T identifier](id T) (driver.Value, error) {
+	s, err := formatIdentifier(id, ErrValueSQL)
+	if err != nil {
+		return nil, err
+	}
+
+	if s == "" {
+		return nil, nil
+	}
+
+	return s, nil
+}
+
+// parseIdentifier sets the identifier from its string representation
+// according to the current MarshalPolicy.
+func parseIdentifier[T identifier](id *T, s string, parse func(string) (T, error)) error {
+	if s == "" {
+		*id = ""
+
+		return nil
+	}
+
+	v, err := parse(s)
+	if err != nil {
+		if isLenient() {
+			*id = ""
+
+			return nil
+		}
+
+		return err
+	}
+
+	*id = v
+
+	return nil
+}
+
+// formatIdentifier returns string representation of the identifier
+// according to the current MarshalPolicy.
+// For the zero value it returns an empty string.
+func formatIdentifier[T identifier](id T, errInvalid error) (string, error) {
+	if id == "" {
+		return "", nil
+	}
+
+	if !id.IsValid() {
+		if isLenient() {
+			return "", nil
+		}
+
+		return "", errInvalid
+	}
+
+	return string(id), nil
+}
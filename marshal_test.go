@@ -0,0 +1,186 @@
+package isocodes
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestSetMarshalPolicy(t *testing.T) {
+	t.Cleanup(func() { SetMarshalPolicy(MarshalStrict) })
+
+	if got := CurrentMarshalPolicy(); got != MarshalStrict {
+		t.Errorf("CurrentMarshalPolicy() got = %v, want %v", got, MarshalStrict)
+	}
+
+	SetMarshalPolicy(MarshalLenient)
+
+	if got := CurrentMarshalPolicy(); got != MarshalLenient {
+		t.Errorf("CurrentMarshalPolicy() got = %v, want %v", got, MarshalLenient)
+	}
+}
+
+func TestMarshalPolicy_Lenient(t *testing.T) {
+	SetMarshalPolicy(MarshalLenient)
+	t.Cleanup(func() { SetMarshalPolicy(MarshalStrict) })
+
+	t.Run("CountryCode_MarshalJSON", func(t *testing.T) {
+		got, err := CountryCode(250).MarshalJSON()
+		if err != nil {
+			t.Fatalf("MarshalJSON() unexpected error = %v", err)
+		}
+
+		if string(got) != jsonNull {
+			t.Errorf("MarshalJSON() got = %s, want %s", got, jsonNull)
+		}
+	})
+
+	t.Run("CountryCode_UnmarshalJSON", func(t *testing.T) {
+		code := AD
+		if err := code.UnmarshalJSON([]byte(`"ZZ"`)); err != nil {
+			t.Fatalf("UnmarshalJSON() unexpected error = %v", err)
+		}
+
+		if code != UnknownCountry {
+			t.Errorf("UnmarshalJSON() got = %v, want %v", code, UnknownCountry)
+		}
+	})
+
+	t.Run("CurrencyCode_MarshalText", func(t *testing.T) {
+		got, err := CurrencyCode(250).MarshalText()
+		if err != nil {
+			t.Fatalf("MarshalText() unexpected error = %v", err)
+		}
+
+		if len(got) != 0 {
+			t.Errorf("MarshalText() got = %s, want empty", got)
+		}
+	})
+
+	t.Run("CurrencyCode_Value", func(t *testing.T) {
+		got, err := CurrencyCode(250).Value()
+		if err != nil {
+			t.Fatalf("Value() unexpected error = %v", err)
+		}
+
+		if got != nil {
+			t.Errorf("Value() got = %v, want nil", got)
+		}
+	})
+}
+
+func TestMarshalJSON_Struct(t *testing.T) {
+	type payload struct {
+		Country  CountryCode  `json:"country"`
+		Currency CurrencyCode `json:"currency,omitempty"`
+		Map      map[CountryCode]CurrencyCode
+	}
+
+	type tcase struct {
+		in   payload
+		want string
+	}
+
+	tests := map[string]tcase{
+		"Full": {
+			in:   payload{Country: GB, Currency: GBP, Map: map[CountryCode]CurrencyCode{DE: EUR}},
+			want: `{"country":"GB","currency":"GBP","Map":{"\"DE\"":"EUR"}}`,
+		},
+		"Zero": {
+			in:   payload{},
+			want: `{"country":null,"Map":null}`,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			b, err := json.Marshal(tc.in)
+			if err != nil {
+				t.Fatalf("json.Marshal() unexpected error = %v", err)
+			}
+
+			if string(b) != tc.want {
+				t.Errorf("json.Marshal() got = %s, want %s", b, tc.want)
+			}
+
+			var got payload
+			if err := json.Unmarshal(b, &got); err != nil {
+				t.Fatalf("json.Unmarshal() unexpected error = %v", err)
+			}
+
+			if !reflect.DeepEqual(got, tc.in) {
+				t.Errorf("json.Unmarshal() got = %v, want %v", got, tc.in)
+			}
+		})
+	}
+}
+
+func TestMarshalText_Zero(t *testing.T) {
+	type textCode interface {
+		MarshalText() ([]byte, error)
+		UnmarshalText([]byte) error
+	}
+
+	type tcase struct {
+		zero  textCode
+		valid textCode
+	}
+
+	country, currency := DE, EUR
+
+	tests := map[string]tcase{
+		"CountryCode":  {zero: new(CountryCode), valid: &country},
+		"CurrencyCode": {zero: new(CurrencyCode), valid: &currency},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			text, err := tc.zero.MarshalText()
+			if err != nil || len(text) != 0 {
+				t.Fatalf("MarshalText() got = %q, %v, want empty", text, err)
+			}
+
+			if err := tc.valid.UnmarshalText(text); err != nil {
+				t.Fatalf("UnmarshalText() unexpected error = %v", err)
+			}
+
+			if !reflect.DeepEqual(tc.valid, tc.zero) {
+				t.Errorf("UnmarshalText() got = %v, want the zero value", tc.valid)
+			}
+		})
+	}
+}
+
+func TestMarshalText_Invalid(t *testing.T) {
+	if _, err := CountryCode(250).MarshalText(); !errors.Is(err, ErrMarshalText) {
+		t.Errorf("MarshalText() error = %v, wantErr %v", err, ErrMarshalText)
+	}
+}
+
+func TestUnmarshalIdentifierJSON_Empty(t *testing.T) {
+	type tcase struct {
+		unmarshal func([]byte) error
+		wantType  CodeType
+	}
+
+	tests := map[string]tcase{
+		"BIC":       {new(BIC).UnmarshalJSON, CodeTypeBIC},
+		"IBAN":      {new(IBAN).UnmarshalJSON, CodeTypeIBAN},
+		"ISIN":      {new(ISIN).UnmarshalJSON, CodeTypeISIN},
+		"LEI":       {new(LEI).UnmarshalJSON, CodeTypeLEI},
+		"MIC":       {new(MIC).UnmarshalJSON, CodeTypeMIC},
+		"VATNumber": {new(VATNumber).UnmarshalJSON, CodeTypeVATNumber},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.unmarshal(nil)
+
+			var parseErr *ParseError
+			if !errors.As(err, &parseErr) || parseErr.Type != tc.wantType || !errors.Is(err, ErrUnmarshalJSON) {
+				t.Errorf("UnmarshalJSON() error = %#v, want *ParseError of %v wrapping %v", err, tc.wantType, ErrUnmarshalJSON)
+			}
+		})
+	}
+}
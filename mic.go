@@ -0,0 +1,222 @@
+package isocodes
+
+import (
+	"database/sql/driver"
+	"sort"
+	"strings"
+	"sync"
+)
+
+//go:generate go run gen_mic.go -in data/ISO10383_MIC.csv -out mic_data.go
+
+// MIC represents ISO 10383 Market Identifier Code, e.g. "XNYS".
+// Use ParseMIC to obtain a known MIC from the user input.
+type MIC string
+
+// MICType represents the type of the MIC in the operating and segment MIC hierarchy.
+type MICType string
+
+const (
+	// MICOperating identifies the entity operating an exchange or trading platform.
+	MICOperating MICType = "OPRT"
+
+	// MICSegment identifies a section of the exchange or trading platform
+	// which specializes in specific instruments or regulated activities.
+	MICSegment MICType = "SGMT"
+)
+
+// MICStatus represents the status of the MIC in the ISO 10383 registry.
+type MICStatus string
+
+const (
+	// MICStatusActive - the MIC is in use.
+	MICStatusActive MICStatus = "ACTIVE"
+
+	// MICStatusUpdated - the MIC is in use, but its details were changed recently.
+	MICStatusUpdated MICStatus = "UPDATED"
+
+	// MICStatusExpired - the MIC is no longer in use.
+	MICStatusExpired MICStatus = "EXPIRED"
+)
+
+// MICDetails represents details of the market identifier code.
+type MICDetails struct {
+	MIC          MIC          `json:"mic"`
+	OperatingMIC MIC          `json:"operatingMic"`
+	Type         MICType      `json:"type"`
+	Name         string       `json:"name"`
+	Acronym      string       `json:"acronym"`
+	Country      CountryCode  `json:"country"`
+	City         string       `json:"city"`
+	Website      string       `json:"website"`
+	Status       MICStatus    `json:"status"`
+	Currency     CurrencyCode `json:"currency"`
+}
+
+// ParseMIC takes case-insensitive MIC and returns the MIC
+// if it's known to the ISO 10383 registry, including expired MICs.
+//
+// Unknown MICs cause *ParseError which wraps ErrUnknownMIC.
+func ParseMIC(s string) (MIC, error) {
+	mic := MIC(strings.ToUpper(s))
+
+	if _, ok := micIndex()[mic]; !ok {
+		return "", mic.parseError(s, ErrUnknownMIC)
+	}
+
+	return mic, nil
+}
+
+// ListMICs returns a list of all known MICs sorted by string representation.
+func ListMICs() []MIC {
+	mics := make([]MIC, 0, len(micRecords))
+
+	for mic := range micIndex() {
+		mics = append(mics, mic)
+	}
+
+	sort.Slice(mics, func(i, j int) bool { return mics[i] < mics[j] })
+
+	return mics
+}
+
+// String returns string representation of the MIC.
+func (m MIC) String() string { return string(m) }
+
+// IsValid reports whether the MIC is known.
+func (m MIC) IsValid() bool {
+	_, ok := micIndex()[m]
+
+	return ok
+}
+
+// Details returns details of the MIC. For unknown MICs it returns the zero value.
+func (m MIC) Details() MICDetails { return micIndex()[m] }
+
+// OperatingMIC returns the operating MIC of the segment MIC.
+// For the operating MIC it returns the MIC itself.
+func (m MIC) OperatingMIC() MIC { return m.Details().OperatingMIC }
+
+// Type returns the type of the MIC.
+func (m MIC) Type() MICType { return m.Details().Type }
+
+// IsOperating reports whether the MIC is an operating MIC.
+func (m MIC) IsOperating() bool { return m.Type() == MICOperating }
+
+// IsSegment reports whether the MIC is a segment MIC.
+func (m MIC) IsSegment() bool { return m.Type() == MICSegment }
+
+// Segments returns segment MICs of the operating MIC sorted by string representation.
+func (m MIC) Segments() []MIC {
+	micIndexOnce.Do(buildMICIndex)
+
+	return append([]MIC(nil), micSegments[m]...)
+}
+
+// Name returns the market name of the MIC.
+func (m MIC) Name() string { return m.Details().Name }
+
+// Acronym returns the known acronym of the market, e.g. "NYSE".
+func (m MIC) Acronym() string { return m.Details().Acronym }
+
+// CountryCode returns the country of the market. For MICs which aren't
+// related to a country, e.g. XOFF, it returns UnknownCountry.
+func (m MIC) CountryCode() CountryCode { return m.Details().Country }
+
+// City returns the city of the market.
+func (m MIC) City() string { return m.Details().City }
+
+// Website returns the website of the market.
+func (m MIC) Website() string { return m.Details().Website }
+
+// Status returns the status of the MIC.
+func (m MIC) Status() MICStatus { return m.Details().Status }
+
+// Currency returns the default trading currency of the market,
+// which is the currency of the market country.
+func (m MIC) Currency() CurrencyCode { return m.Details().Currency }
+
+// MarshalJSON implements json.Marshaler.
+// The zero value is marshalled as JSON null.
+func (m MIC) MarshalJSON() ([]byte, error) { return marshalIdentifierJSON(m) }
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (m *MIC) UnmarshalJSON(b []byte) error { return unmarshalIdentifierJSON(m, b, ParseMIC) }
+
+// MarshalText implements encoding.TextMarshaler.
+// The zero value is marshalled as an empty text.
+func (m MIC) MarshalText() ([]byte, error) { return marshalIdentifierText(m) }
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// An empty text is unmarshalled into the zero value.
+func (m *MIC) UnmarshalText(b []byte) error { return parseIdentifier(m, string(b), ParseMIC) }
+
+// Scan implements sql.Scanner.
+// SQL NULL and an empty string are scanned into the zero value.
+func (m *MIC) Scan(src any) error { return scanIdentifierSQL(m, src, ParseMIC) }
+
+// Value implements driver.Valuer.
+// The zero value is stored as SQL NULL.
+func (m MIC) Value() (driver.Value, error) { return valueIdentifierSQL(m) }
+
+// parseError returns ParseError for the invalid MIC with the error.
+func (MIC) parseError(input string, err error) *ParseError {
+	return &ParseError{Input: input, Type: CodeTypeMIC, Format: FormatISO10383, Err: err}
+}
+
+// micRecord represents a row of the ISO 10383 CSV.
+// Records are generated by gen_mic.go into mic_data.go.
+type micRecord struct {
+	mic       string
+	operating string
+	kind      string
+	name      string
+	acronym   string
+	country   string
+	city      string
+	website   string
+	status    string
+	currency  string
+}
+
+var (
+	micIndexOnce sync.Once
+	micDetails   map[MIC]MICDetails
+	micSegments  map[MIC][]MIC
+)
+
+// micIndex returns MIC details by MIC.
+func micIndex() map[MIC]MICDetails {
+	micIndexOnce.Do(buildMICIndex)
+
+	return micDetails
+}
+
+// buildMICIndex builds MIC details and segments indexes from the generated records.
+func buildMICIndex() {
+	micDetails = make(map[MIC]MICDetails, len(micRecords))
+	micSegments = make(map[MIC][]MIC)
+
+	for _, r := range micRecords {
+		micDetails[MIC(r.mic)] = MICDetails{
+			MIC:          MIC(r.mic),
+			OperatingMIC: MIC(r.operating),
+			Type:         MICType(r.kind),
+			Name:         r.name,
+			Acronym:      r.acronym,
+			Country:      stringToCountryCode[r.country],
+			City:         r.city,
+			Website:      r.website,
+			Status:       MICStatus(r.status),
+			Currency:     stringToCurrencyCode[r.currency],
+		}
+
+		if r.kind == string(MICSegment) {
+			micSegments[MIC(r.operating)] = append(micSegments[MIC(r.operating)], MIC(r.mic))
+		}
+	}
+
+	for _, segments := range micSegments {
+		sort.Slice(segments, func(i, j int) bool { return segments[i] < segments[j] })
+	}
+}
@@ -0,0 +1,58 @@
+// Code generated by gen_mic.go from data/ISO10383_MIC.csv; DO NOT EDIT.
+
+package isocodes
+
+// micRecords holds ISO 10383 market identifier codes.
+var micRecords = []micRecord{
+	{"XNYS", "XNYS", "OPRT", "NEW YORK STOCK EXCHANGE, INC.", "NYSE", "US", "NEW YORK", "www.nyse.com", "ACTIVE", "USD"},
+	{"ARCX", "XNYS", "SGMT", "NYSE ARCA", "NYSE", "US", "NEW YORK", "www.nyse.com", "ACTIVE", "USD"},
+	{"XASE", "XNYS", "SGMT", "NYSE AMERICAN", "NYSE", "US", "NEW YORK", "www.nyse.com", "ACTIVE", "USD"},
+	{"XNAS", "XNAS", "OPRT", "NASDAQ - ALL MARKETS", "NASDAQ", "US", "NEW YORK", "www.nasdaq.com", "ACTIVE", "USD"},
+	{"XNGS", "XNAS", "SGMT", "NASDAQ/NGS (GLOBAL SELECT MARKET)", "NGS", "US", "NEW YORK", "www.nasdaq.com", "ACTIVE", "USD"},
+	{"XNMS", "XNAS", "SGMT", "NASDAQ/NMS (GLOBAL MARKET)", "NMS", "US", "NEW YORK", "www.nasdaq.com", "ACTIVE", "USD"},
+	{"XNCM", "XNAS", "SGMT", "NASDAQ CAPITAL MARKET", "NCM", "US", "NEW YORK", "www.nasdaq.com", "ACTIVE", "USD"},
+	{"XCME", "XCME", "OPRT", "CHICAGO MERCANTILE EXCHANGE", "CME", "US", "CHICAGO", "www.cmegroup.com", "ACTIVE", "USD"},
+	{"GLBX", "XCME", "SGMT", "CME GLOBEX", "", "US", "CHICAGO", "www.cmegroup.com", "ACTIVE", "USD"},
+	{"XCBT", "XCME", "SGMT", "CHICAGO BOARD OF TRADE", "CBOT", "US", "CHICAGO", "www.cmegroup.com", "ACTIVE", "USD"},
+	{"XNYM", "XCME", "SGMT", "NEW YORK MERCANTILE EXCHANGE", "NYMEX", "US", "NEW YORK", "www.cmegroup.com", "ACTIVE", "USD"},
+	{"XCBO", "XCBO", "OPRT", "CBOE OPTIONS EXCHANGE", "CBOE", "US", "CHICAGO", "www.cboe.com", "ACTIVE", "USD"},
+	{"XTSE", "XTSE", "OPRT", "TORONTO STOCK EXCHANGE", "TSX", "CA", "TORONTO", "www.tsx.com", "ACTIVE", "CAD"},
+	{"XMEX", "XMEX", "OPRT", "BOLSA MEXICANA DE VALORES (MEXICAN STOCK EXCHANGE)", "BMV", "MX", "MEXICO", "www.bmv.com.mx", "ACTIVE", "MXN"},
+	{"BVMF", "BVMF", "OPRT", "B3 - BRASIL BOLSA BALCAO S.A.", "B3", "BR", "SAO PAULO", "www.b3.com.br", "ACTIVE", "BRL"},
+	{"XLON", "XLON", "OPRT", "LONDON STOCK EXCHANGE", "LSE", "GB", "LONDON", "www.londonstockexchange.com", "ACTIVE", "GBP"},
+	{"AIMX", "XLON", "SGMT", "AIM", "", "GB", "LONDON", "www.londonstockexchange.com", "ACTIVE", "GBP"},
+	{"XETR", "XETR", "OPRT", "XETRA", "", "DE", "FRANKFURT", "www.deutsche-boerse.com", "ACTIVE", "EUR"},
+	{"XFRA", "XFRA", "OPRT", "BOERSE FRANKFURT", "FSX", "DE", "FRANKFURT", "www.deutsche-boerse.com", "ACTIVE", "EUR"},
+	{"XEUR", "XEUR", "OPRT", "EUREX DEUTSCHLAND", "", "DE", "FRANKFURT", "www.eurexchange.com", "ACTIVE", "EUR"},
+	{"XPAR", "XPAR", "OPRT", "EURONEXT - EURONEXT PARIS", "", "FR", "PARIS", "www.euronext.com", "ACTIVE", "EUR"},
+	{"XAMS", "XAMS", "OPRT", "EURONEXT - EURONEXT AMSTERDAM", "", "NL", "AMSTERDAM", "www.euronext.com", "ACTIVE", "EUR"},
+	{"XBRU", "XBRU", "OPRT", "EURONEXT - EURONEXT BRUSSELS", "", "BE", "BRUSSELS", "www.euronext.com", "ACTIVE", "EUR"},
+	{"XLIS", "XLIS", "OPRT", "EURONEXT - EURONEXT LISBON", "", "PT", "LISBON", "www.euronext.com", "ACTIVE", "EUR"},
+	{"XMIL", "XMIL", "OPRT", "BORSA ITALIANA S.P.A.", "", "IT", "MILANO", "www.borsaitaliana.it", "ACTIVE", "EUR"},
+	{"BMEX", "BMEX", "OPRT", "BME - BOLSAS Y MERCADOS ESPANOLES", "BME", "ES", "MADRID", "www.bolsasymercados.es", "ACTIVE", "EUR"},
+	{"XMAD", "BMEX", "SGMT", "BOLSA DE MADRID", "", "ES", "MADRID", "www.bolsamadrid.es", "ACTIVE", "EUR"},
+	{"XSWX", "XSWX", "OPRT", "SIX SWISS EXCHANGE", "SIX", "CH", "ZURICH", "www.six-group.com", "ACTIVE", "CHF"},
+	{"XVTX", "XSWX", "SGMT", "SIX SWISS EXCHANGE - BLUE CHIPS SEGMENT", "", "CH", "ZURICH", "www.six-group.com", "ACTIVE", "CHF"},
+	{"XVIR", "XVIR", "OPRT", "VIRT-X", "", "CH", "ZURICH", "www.virt-x.com", "EXPIRED", "CHF"},
+	{"XSTO", "XSTO", "OPRT", "NASDAQ STOCKHOLM AB", "", "SE", "STOCKHOLM", "www.nasdaqomxnordic.com", "ACTIVE", "SEK"},
+	{"XCSE", "XCSE", "OPRT", "NASDAQ COPENHAGEN A/S", "", "DK", "COPENHAGEN", "www.nasdaqomxnordic.com", "ACTIVE", "DKK"},
+	{"XHEL", "XHEL", "OPRT", "NASDAQ HELSINKI LTD", "", "FI", "HELSINKI", "www.nasdaqomxnordic.com", "ACTIVE", "EUR"},
+	{"XOSL", "XOSL", "OPRT", "EURONEXT OSLO BORS", "", "NO", "OSLO", "www.euronext.com", "ACTIVE", "NOK"},
+	{"XWAR", "XWAR", "OPRT", "WARSAW STOCK EXCHANGE/EQUITIES/MAIN MARKET", "GPW", "PL", "WARSZAWA", "www.gpw.pl", "ACTIVE", "PLN"},
+	{"XIST", "XIST", "OPRT", "BORSA ISTANBUL", "", "TR", "ISTANBUL", "www.borsaistanbul.com", "ACTIVE", "TRY"},
+	{"MISX", "MISX", "OPRT", "MOSCOW EXCHANGE", "MOEX", "RU", "MOSCOW", "www.moex.com", "ACTIVE", "RUB"},
+	{"XJSE", "XJSE", "OPRT", "JOHANNESBURG STOCK EXCHANGE", "JSE", "ZA", "JOHANNESBURG", "www.jse.co.za", "ACTIVE", "ZAR"},
+	{"XJPX", "XJPX", "OPRT", "JAPAN EXCHANGE GROUP", "JPX", "JP", "TOKYO", "www.jpx.co.jp", "ACTIVE", "JPY"},
+	{"XTKS", "XJPX", "SGMT", "TOKYO STOCK EXCHANGE", "TSE", "JP", "TOKYO", "www.jpx.co.jp", "ACTIVE", "JPY"},
+	{"XOSE", "XJPX", "SGMT", "OSAKA EXCHANGE", "OSE", "JP", "OSAKA", "www.jpx.co.jp", "ACTIVE", "JPY"},
+	{"XHKG", "XHKG", "OPRT", "HONG KONG EXCHANGES AND CLEARING LTD", "HKEX", "HK", "HONG KONG", "www.hkex.com.hk", "ACTIVE", "HKD"},
+	{"XSHG", "XSHG", "OPRT", "SHANGHAI STOCK EXCHANGE", "SSE", "CN", "SHANGHAI", "www.sse.com.cn", "ACTIVE", "CNY"},
+	{"XSHE", "XSHE", "OPRT", "SHENZHEN STOCK EXCHANGE", "SZSE", "CN", "SHENZHEN", "www.szse.cn", "ACTIVE", "CNY"},
+	{"XKRX", "XKRX", "OPRT", "KOREA EXCHANGE", "KRX", "KR", "SEOUL", "www.krx.co.kr", "ACTIVE", "KRW"},
+	{"XBOM", "XBOM", "OPRT", "BSE LTD", "BSE", "IN", "MUMBAI", "www.bseindia.com", "ACTIVE", "INR"},
+	{"XNSE", "XNSE", "OPRT", "NATIONAL STOCK EXCHANGE OF INDIA", "NSE", "IN", "MUMBAI", "www.nseindia.com", "ACTIVE", "INR"},
+	{"XSES", "XSES", "OPRT", "SINGAPORE EXCHANGE", "SGX", "SG", "SINGAPORE", "www.sgx.com", "ACTIVE", "SGD"},
+	{"XASX", "XASX", "OPRT", "ASX - ALL MARKETS", "ASX", "AU", "SYDNEY", "www.asx.com.au", "ACTIVE", "AUD"},
+	{"XOFF", "XOFF", "OPRT", "OFF-EXCHANGE TRANSACTIONS - LISTED INSTRUMENTS", "", "ZZ", "", "", "ACTIVE", ""},
+	{"XXXX", "XXXX", "OPRT", "NO MARKET (EG, UNLISTED)", "", "ZZ", "", "", "ACTIVE", ""},
+}
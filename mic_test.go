@@ -0,0 +1,141 @@
+package isocodes
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseMIC(t *testing.T) {
+	type tcase struct {
+		input   string
+		want    MIC
+		wantErr error
+	}
+
+	tests := map[string]tcase{
+		"Operating": {"XNYS", "XNYS", nil},
+		"Segment":   {"arcx", "ARCX", nil},
+		"Expired":   {"XVIR", "XVIR", nil},
+		"Unknown":   {"QQQQ", "", ErrUnknownMIC},
+		"Empty":     {"", "", ErrUnknownMIC},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseMIC(tc.input)
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Errorf("ParseMIC() error = %v, wantErr %v", err, tc.wantErr)
+				}
+			} else {
+				if err != nil || got != tc.want {
+					t.Errorf("ParseMIC() got = %v, %v, want %v", got, err, tc.want)
+				}
+			}
+		})
+	}
+}
+
+func TestMIC_Details(t *testing.T) {
+	type tcase struct {
+		mic       MIC
+		operating MIC
+		country   CountryCode
+		currency  CurrencyCode
+		status    MICStatus
+		segment   bool
+	}
+
+	tests := map[string]tcase{
+		"XNYS":    {"XNYS", "XNYS", US, USD, MICStatusActive, false},
+		"ARCX":    {"ARCX", "XNYS", US, USD, MICStatusActive, true},
+		"XTKS":    {"XTKS", "XJPX", JP, JPY, MICStatusActive, true},
+		"XLON":    {"XLON", "XLON", GB, GBP, MICStatusActive, false},
+		"XVIR":    {"XVIR", "XVIR", CH, CHF, MICStatusExpired, false},
+		"XOFF":    {"XOFF", "XOFF", UnknownCountry, UnknownCurrency, MICStatusActive, false},
+		"Unknown": {"QQQQ", "", UnknownCountry, UnknownCurrency, "", false},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := tc.mic.OperatingMIC(); got != tc.operating {
+				t.Errorf("OperatingMIC() got = %v, want %v", got, tc.operating)
+			}
+
+			if got := tc.mic.CountryCode(); got != tc.country {
+				t.Errorf("CountryCode() got = %v, want %v", got, tc.country)
+			}
+
+			if got := tc.mic.Currency(); got != tc.currency {
+				t.Errorf("Currency() got = %v, want %v", got, tc.currency)
+			}
+
+			if got := tc.mic.Status(); got != tc.status {
+				t.Errorf("Status() got = %v, want %v", got, tc.status)
+			}
+
+			if got := tc.mic.IsSegment(); got != tc.segment {
+				t.Errorf("IsSegment() got = %v, want %v", got, tc.segment)
+			}
+		})
+	}
+
+	t.Run("Fields", func(t *testing.T) {
+		xnys := MIC("XNYS")
+
+		if xnys.Name() != "NEW YORK STOCK EXCHANGE, INC." || xnys.Acronym() != "NYSE" ||
+			xnys.City() != "NEW YORK" || xnys.Website() != "www.nyse.com" || !xnys.IsOperating() {
+			t.Errorf("Details() got = %+v", xnys.Details())
+		}
+	})
+
+	t.Run("Segments", func(t *testing.T) {
+		if got, want := MIC("XNYS").Segments(), []MIC{"ARCX", "XASE"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("Segments() got = %v, want %v", got, want)
+		}
+
+		MIC("XNYS").Segments()[0] = "XXXX"
+
+		if got := MIC("XNYS").Segments()[0]; got != "ARCX" {
+			t.Errorf("Segments() got = %v after modification of the result, want ARCX", got)
+		}
+
+		if got := MIC("ARCX").Segments(); len(got) != 0 {
+			t.Errorf("Segments() got = %v, want empty", got)
+		}
+	})
+
+	t.Run("Hierarchy", func(t *testing.T) {
+		for _, m := range ListMICs() {
+			if op := m.OperatingMIC(); !op.IsOperating() {
+				t.Errorf("%v OperatingMIC() = %v is not an operating MIC", m, op)
+			}
+		}
+	})
+}
+
+func TestMIC_Marshal(t *testing.T) {
+	type order struct {
+		Venue MIC `json:"venue"`
+	}
+
+	b, err := json.Marshal(order{Venue: "XNYS"})
+	if err != nil || string(b) != `{"venue":"XNYS"}` {
+		t.Errorf("Marshal() got = %s, %v", b, err)
+	}
+
+	var got order
+	if err := json.Unmarshal([]byte(`{"venue":"xlon"}`), &got); err != nil || got.Venue != "XLON" {
+		t.Errorf("Unmarshal() got = %v, %v", got.Venue, err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"venue":"QQQQ"}`), &got); !errors.Is(err, ErrUnknownMIC) {
+		t.Errorf("Unmarshal() error = %v, wantErr %v", err, ErrUnknownMIC)
+	}
+
+	if v, err := MIC("").Value(); err != nil || v != nil {
+		t.Errorf("Value() got = %v, %v, want nil", v, err)
+	}
+}
@@ -0,0 +1,518 @@
+package isocodes
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// maxE164Digits holds the maximum number of digits in E.164 phone number.
+const maxE164Digits = 15
+
+// minE164Digits holds the minimum number of digits in E.164 phone number
+// accepted by the package, which is the length of the shortest numbers in use.
+const minE164Digits = 7
+
+// CallingCodes returns ITU-T E.164 international calling codes
+// of the country, e.g. "+44". Most of the countries have a single
+// calling code, while some countries have none, e.g. Bouvet Island.
+func (c CountryCode) CallingCodes() []string {
+	codes := countryCallingCodes[c]
+	if len(codes) == 0 {
+		return nil
+	}
+
+	calling := make([]string, 0, len(codes))
+
+	for _, code := range codes {
+		calling = append(calling, "+"+code)
+	}
+
+	return calling
+}
+
+// CountriesForCallingCode returns countries which share the calling code,
+// e.g. "+7" returns RU and KZ. The leading plus sign is optional.
+// The main country of the calling code is returned first, others follow
+// in alphabetical order.
+func CountriesForCallingCode(code string) []CountryCode {
+	index := callingCodesIndex()
+
+	countries := index[strings.TrimPrefix(code, "+")]
+	if len(countries) == 0 {
+		return nil
+	}
+
+	return append([]CountryCode(nil), countries...)
+}
+
+// CountriesForPhoneNumber returns candidate countries of the phone number
+// in E.164 format, e.g. "+1 242 555 0100" returns BS. Spaces, dots,
+// hyphens and parentheses are ignored.
+//
+// When the calling code is shared by several countries, the national
+// prefixes are used to resolve the country, e.g. NANP area codes for +1
+// or 6 and 7 for Kazakhstan within +7. When the country can't be resolved,
+// all countries which share the calling code are returned, the main
+// country of the calling code first.
+//
+// Invalid numbers cause *ParseError which wraps ErrInvalidPhoneNumber
+// or ErrUnknownCallingCode.
+func CountriesForPhoneNumber(e164 string) ([]CountryCode, error) {
+	digits, err := e164Digits(e164)
+	if err != nil {
+		return nil, err
+	}
+
+	code, ok := callingCodeOf(digits)
+	if !ok {
+		return nil, phoneParseError(e164, ErrUnknownCallingCode)
+	}
+
+	if c, ok := longestPrefixMatch(digits); ok {
+		return []CountryCode{c}, nil
+	}
+
+	// The number doesn't match any national prefix, so countries
+	// which are identified by national prefixes are excluded.
+	candidates := make([]CountryCode, 0, 1)
+
+	for _, c := range callingCodesIndex()[code] {
+		if !callingCodePrefixed(code, c) {
+			candidates = append(candidates, c)
+		}
+	}
+
+	// Every country of the calling code is identified by national
+	// prefixes, e.g. +672, so none of them can be excluded.
+	if len(candidates) == 0 {
+		return append(candidates, callingCodesIndex()[code]...), nil
+	}
+
+	return candidates, nil
+}
+
+// e164Digits returns digits of the phone number in E.164 format.
+func e164Digits(e164 string) (string, error) {
+	if !strings.HasPrefix(e164, "+") {
+		return "", phoneParseError(e164, ErrInvalidPhoneNumber)
+	}
+
+	digits, ok := phoneDigits(e164[1:])
+	if !ok || len(digits) < minE164Digits || len(digits) > maxE164Digits || digits[0] == '0' {
+		return "", phoneParseError(e164, ErrInvalidPhoneNumber)
+	}
+
+	return digits, nil
+}
+
+// phoneDigits returns digits of the phone number ignoring
+// spaces, dots, hyphens, slashes and parentheses.
+// It reports false when the number contains other characters.
+func phoneDigits(number string) (string, bool) {
+	var b strings.Builder
+
+	for _, r := range number {
+		switch {
+		case r >= '0' && r <= '9':
+			b.WriteRune(r)
+		case r == ' ' || r == '-' || r == '.' || r == '/' || r == '(' || r == ')':
+			continue
+		default:
+			return "", false
+		}
+	}
+
+	return b.String(), true
+}
+
+// callingCodeOf returns the calling code which the digits start with.
+// Calling codes are prefix-free, so at most one code matches.
+func callingCodeOf(digits string) (string, bool) {
+	index := callingCodesIndex()
+
+	for i := 1; i <= 3 && i <= len(digits); i++ {
+		if _, ok := index[digits[:i]]; ok {
+			return digits[:i], true
+		}
+	}
+
+	return "", false
+}
+
+// longestPrefixMatch returns the country identified by
+// the longest national prefix which the digits start with.
+func longestPrefixMatch(digits string) (CountryCode, bool) {
+	for i := len(digits); i > 0; i-- {
+		if c, ok := callingCodePrefixes[digits[:i]]; ok {
+			return c, true
+		}
+	}
+
+	return 0, false
+}
+
+// callingCodePrefixed reports whether the country is identified
+// by national prefixes within the shared calling code.
+func callingCodePrefixed(code string, c CountryCode) bool {
+	callingCodesOnce.Do(buildCallingCodesIndex)
+
+	_, ok := callingCodesPrefixed[code][c]
+
+	return ok
+}
+
+// phoneParseError returns ParseError for the invalid phone number.
+func phoneParseError(input string, err error) *ParseError {
+	return &ParseError{Input: input, Type: CodeTypePhoneNumber, Format: FormatE164, Err: err}
+}
+
+var (
+	callingCodesOnce     sync.Once
+	callingCodesByCode   map[string][]CountryCode
+	callingCodesPrefixed map[string]map[CountryCode]struct{}
+)
+
+// callingCodesIndex returns countries by calling code.
+func callingCodesIndex() map[string][]CountryCode {
+	callingCodesOnce.Do(buildCallingCodesIndex)
+
+	return callingCodesByCode
+}
+
+// buildCallingCodesIndex builds reverse indexes of calling codes data.
+func buildCallingCodesIndex() {
+	callingCodesByCode = make(map[string][]CountryCode)
+	callingCodesPrefixed = make(map[string]map[CountryCode]struct{})
+
+	for c, codes := range countryCallingCodes {
+		for _, code := range codes {
+			callingCodesByCode[code] = append(callingCodesByCode[code], c)
+		}
+	}
+
+	for code, countries := range callingCodesByCode {
+		primary := callingCodesMain[code]
+
+		sort.Slice(countries, func(i, j int) bool {
+			if (countries[i] == primary) != (countries[j] == primary) {
+				return countries[i] == primary
+			}
+
+			return countries[i].String() < countries[j].String()
+		})
+	}
+
+	for prefix, c := range callingCodePrefixes {
+		for i := 1; i <= 3; i++ {
+			code := prefix[:i]
+			if _, ok := callingCodesByCode[code]; !ok {
+				continue
+			}
+
+			if callingCodesPrefixed[code] == nil {
+				callingCodesPrefixed[code] = make(map[CountryCode]struct{})
+			}
+
+			callingCodesPrefixed[code][c] = struct{}{}
+
+			break
+		}
+	}
+}
+
+// PhoneNumberLength returns the minimum and the maximum length
+// of the national significant number of the country, which is
+// the phone number without the calling code and the trunk prefix.
+// For countries without phone numbering data it returns zeros.
+func (c CountryCode) PhoneNumberLength() (int, int) {
+	n := countryPhoneNumbering[c]
+
+	return n.minLength, n.maxLength
+}
+
+// TrunkPrefix returns the national trunk prefix of the country,
+// which is dialled before the national significant number within
+// the country, e.g. "0" for GB and DE or "1" for US.
+// Countries without trunk prefix return an empty string.
+func (c CountryCode) TrunkPrefix() string { return countryPhoneNumbering[c].trunkPrefix }
+
+// NormalizePhoneNumber converts the phone number into E.164 format,
+// e.g. "020 7946 0958" of GB into "+442079460958".
+//
+// The number can be given in the international format starting with
+// the plus sign or the "00" international call prefix, or in the national
+// format of the country, with or without the trunk prefix. Spaces, dots,
+// hyphens, slashes and parentheses are ignored, as well as the "(0)"
+// trunk prefix in the international format, e.g. "+44 (0)20 7946 0958".
+// When the country is UnknownCountry, only the international format is accepted.
+//
+// The length of the national significant number is validated against
+// the numbering plan of the country. When the country is given and the
+// number in the international format belongs to another country, the number
+// is rejected. Invalid numbers cause *ParseError which wraps ErrInvalidPhoneNumber,
+// ErrUnknownCallingCode, ErrPhoneNumberLength or ErrPhoneNumberCountry.
+func NormalizePhoneNumber(number string, country CountryCode) (string, error) {
+	trimmed := strings.TrimSpace(strings.Replace(number, "(0)", "", 1))
+
+	var international string
+
+	switch {
+	case strings.HasPrefix(trimmed, "+"):
+		international = trimmed[1:]
+	case strings.HasPrefix(trimmed, "00"):
+		international = trimmed[2:]
+	default:
+		return normalizeNationalPhoneNumber(number, trimmed, country)
+	}
+
+	digits, ok := phoneDigits(international)
+	if !ok {
+		return "", phoneParseError(number, ErrInvalidPhoneNumber)
+	}
+
+	e164 := "+" + digits
+
+	countries, err := CountriesForPhoneNumber(e164)
+	if err != nil {
+		var perr *ParseError
+		if errors.As(err, &perr) {
+			return "", phoneParseError(number, perr.Err)
+		}
+
+		return "", err
+	}
+
+	if !country.IsZero() && !containsCountry(countries, country) {
+		return "", phoneParseError(number, ErrPhoneNumberCountry)
+	}
+
+	if err := validatePhoneNumberLength(digits, countries); err != nil {
+		return "", phoneParseError(number, err)
+	}
+
+	return e164, nil
+}
+
+// normalizeNationalPhoneNumber converts the phone number in the national
+// format of the country into E.164 format.
+func normalizeNationalPhoneNumber(number, national string, country CountryCode) (string, error) {
+	codes := countryCallingCodes[country]
+
+	digits, ok := phoneDigits(national)
+	if !ok || len(codes) == 0 {
+		return "", phoneParseError(number, ErrInvalidPhoneNumber)
+	}
+
+	// National significant numbers never start with the zero trunk prefix,
+	// while other trunk prefixes, like "8" in RU, are distinguished by length.
+	n := countryPhoneNumbering[country]
+	if n.trunkPrefix != "" && strings.HasPrefix(digits, n.trunkPrefix) &&
+		(n.trunkPrefix[0] == '0' || len(digits) > n.maxLength) {
+		digits = digits[len(n.trunkPrefix):]
+	}
+
+	digits = codes[0] + digits
+
+	// The number in the national format belongs to the country by definition.
+	if err := validatePhoneNumberLength(digits, []CountryCode{country}); err != nil {
+		return "", phoneParseError(number, err)
+	}
+
+	return "+" + digits, nil
+}
+
+// ValidatePhoneNumber validates the phone number in E.164 format
+// and returns the country it belongs to. When the country can't be
+// resolved, the main country of the calling code is returned.
+// Invalid numbers cause the same errors as NormalizePhoneNumber.
+func ValidatePhoneNumber(e164 string) (CountryCode, error) {
+	digits, err := e164Digits(e164)
+	if err != nil {
+		return 0, err
+	}
+
+	countries, err := CountriesForPhoneNumber(e164)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := validatePhoneNumberLength(digits, countries); err != nil {
+		return 0, phoneParseError(e164, err)
+	}
+
+	return countries[0], nil
+}
+
+// validatePhoneNumberLength checks whether the national significant number
+// of the international number digits fits the numbering plan of any of the countries.
+func validatePhoneNumberLength(digits string, countries []CountryCode) error {
+	if len(digits) < minE164Digits || len(digits) > maxE164Digits {
+		return ErrPhoneNumberLength
+	}
+
+	for _, c := range countries {
+		n, ok := countryPhoneNumbering[c]
+		if !ok {
+			return nil
+		}
+
+		for _, code := range countryCallingCodes[c] {
+			if !strings.HasPrefix(digits, code) {
+				continue
+			}
+
+			if l := len(digits) - len(code); l >= n.minLength && l <= n.maxLength {
+				return nil
+			}
+		}
+	}
+
+	return ErrPhoneNumberLength
+}
+
+// containsCountry reports whether the country is in the list.
+func containsCountry(countries []CountryCode, country CountryCode) bool {
+	for _, c := range countries {
+		if c == country {
+			return true
+		}
+	}
+
+	return false
+}
+
+// phoneNumbering represents the national numbering plan of the country.
+type phoneNumbering struct {
+	minLength   int
+	maxLength   int
+	trunkPrefix string
+}
+
+// nanp represents the North American Numbering Plan.
+var nanp = phoneNumbering{minLength: 10, maxLength: 10, trunkPrefix: "1"}
+
+// countryPhoneNumbering holds national numbering plans of countries.
+// Lengths are approximations covering fixed and mobile numbers,
+// short and premium service numbers are not considered.
+var countryPhoneNumbering = map[CountryCode]phoneNumbering{
+	AD: {6, 9, ""}, AE: {8, 9, "0"}, AF: {9, 9, "0"}, AG: nanp, AI: nanp, AL: {8, 9, "0"},
+	AM: {8, 8, "0"}, AO: {9, 9, ""}, AQ: {6, 6, ""}, AR: {10, 11, "0"}, AS: nanp, AT: {4, 13, "0"},
+	AU: {9, 9, "0"}, AW: {7, 7, ""}, AX: {5, 10, "0"}, AZ: {9, 9, "0"}, BA: {8, 9, "0"}, BB: nanp,
+	BD: {8, 10, "0"}, BE: {8, 9, "0"}, BF: {8, 8, ""}, BG: {8, 9, "0"}, BH: {8, 8, ""}, BI: {8, 8, ""},
+	BJ: {8, 10, ""}, BL: {9, 9, "0"}, BM: nanp, BN: {7, 7, ""}, BO: {8, 8, "0"}, BQ: {7, 7, ""},
+	BR: {10, 11, "0"}, BS: nanp, BT: {7, 8, ""}, BW: {7, 8, ""}, BY: {9, 9, "8"}, BZ: {7, 7, ""},
+	CA: nanp, CC: {9, 9, "0"}, CD: {9, 9, "0"}, CF: {8, 8, ""}, CG: {9, 9, ""}, CH: {9, 9, "0"},
+	CI: {10, 10, ""}, CK: {5, 5, ""}, CL: {9, 9, ""}, CM: {9, 9, ""}, CN: {10, 11, "0"}, CO: {10, 10, ""},
+	CR: {8, 8, ""}, CU: {6, 8, "0"}, CV: {7, 7, ""}, CW: {7, 8, ""}, CX: {9, 9, "0"}, CY: {8, 8, ""},
+	CZ: {9, 9, ""}, DE: {6, 13, "0"}, DJ: {8, 8, ""}, DK: {8, 8, ""}, DM: nanp, DO: nanp,
+	DZ: {8, 9, "0"}, EC: {8, 9, "0"}, EE: {7, 8, ""}, EG: {8, 10, "0"}, EH: {9, 9, "0"}, ER: {7, 7, "0"},
+	ES: {9, 9, ""}, ET: {9, 9, "0"}, FI: {5, 12, "0"}, FJ: {7, 7, ""}, FK: {5, 5, ""}, FM: {7, 7, ""},
+	FO: {6, 6, ""}, FR: {9, 9, "0"}, GA: {7, 8, ""}, GB: {9, 10, "0"}, GD: nanp, GE: {9, 9, "0"},
+	GF: {9, 9, "0"}, GG: {10, 10, "0"}, GH: {9, 9, "0"}, GI: {8, 8, ""}, GL: {6, 6, ""}, GM: {7, 7, ""},
+	GN: {8, 9, ""}, GP: {9, 9, "0"}, GQ: {9, 9, ""}, GR: {10, 10, ""}, GS: {5, 5, ""}, GT: {8, 8, ""},
+	GU: nanp, GW: {7, 9, ""}, GY: {7, 7, ""}, HK: {8, 8, ""}, HN: {8, 8, ""}, HR: {8, 9, "0"},
+	HT: {8, 8, ""}, HU: {8, 9, "06"}, ID: {8, 12, "0"}, IE: {7, 9, "0"}, IL: {8, 9, "0"}, IM: {10, 10, "0"},
+	IN: {10, 10, "0"}, IO: {7, 7, ""}, IQ: {8, 10, "0"}, IR: {10, 10, "0"}, IS: {7, 9, ""}, IT: {6, 11, ""},
+	JE: {10, 10, "0"}, JM: nanp, JO: {8, 9, "0"}, JP: {9, 10, "0"}, KE: {9, 9, "0"}, KG: {9, 9, "0"},
+	KH: {8, 9, "0"}, KI: {5, 8, ""}, KM: {7, 7, ""}, KN: nanp, KP: {8, 10, "0"}, KR: {8, 10, "0"},
+	KW: {8, 8, ""}, KY: nanp, KZ: {10, 10, "8"}, LA: {8, 10, "0"}, LB: {7, 8, "0"}, LC: nanp,
+	LI: {7, 7, ""}, LK: {9, 9, "0"}, LR: {7, 9, "0"}, LS: {8, 8, ""}, LT: {8, 8, "8"}, LU: {4, 11, ""},
+	LV: {8, 8, ""}, LY: {9, 9, "0"}, MA: {9, 9, "0"}, MC: {8, 9, ""}, MD: {8, 8, "0"}, ME: {8, 8, "0"},
+	MF: {9, 9, "0"}, MG: {9, 9, "0"}, MH: {7, 7, ""}, MK: {8, 8, "0"}, ML: {8, 8, ""}, MM: {7, 10, "0"},
+	MN: {8, 8, "0"}, MO: {8, 8, ""}, MP: nanp, MQ: {9, 9, "0"}, MR: {8, 8, ""}, MS: nanp,
+	MT: {8, 8, ""}, MU: {7, 8, ""}, MV: {7, 7, ""}, MW: {7, 9, "0"}, MX: {10, 10, ""}, MY: {8, 10, "0"},
+	MZ: {8, 9, ""}, NA: {8, 9, "0"}, NC: {6, 6, ""}, NE: {8, 8, ""}, NF: {6, 6, ""}, NG: {8, 10, "0"},
+	NI: {8, 8, ""}, NL: {9, 9, "0"}, NO: {8, 8, ""}, NP: {8, 10, "0"}, NR: {7, 7, ""}, NU: {4, 7, ""},
+	NZ: {8, 10, "0"}, OM: {8, 8, ""}, PA: {7, 8, ""}, PE: {8, 9, "0"}, PF: {8, 8, ""}, PG: {7, 8, ""},
+	PH: {8, 10, "0"}, PK: {9, 10, "0"}, PL: {9, 9, ""}, PM: {6, 6, "0"}, PN: {9, 9, ""}, PR: nanp,
+	PS: {8, 9, "0"}, PT: {9, 9, ""}, PW: {7, 7, ""}, PY: {8, 9, "0"}, QA: {8, 8, ""}, RE: {9, 9, "0"},
+	RO: {9, 9, "0"}, RS: {8, 10, "0"}, RU: {10, 10, "8"}, RW: {9, 9, "0"}, SA: {9, 9, "0"}, SB: {7, 7, ""},
+	SC: {7, 7, ""}, SD: {9, 9, "0"}, SE: {7, 10, "0"}, SG: {8, 8, ""}, SH: {4, 5, ""}, SI: {8, 8, "0"},
+	SJ: {8, 8, ""}, SK: {9, 9, "0"}, SL: {8, 8, "0"}, SM: {6, 10, ""}, SN: {9, 9, ""}, SO: {7, 9, "0"},
+	SR: {6, 7, ""}, SS: {9, 9, "0"}, ST: {7, 7, ""}, SV: {8, 8, ""}, SX: nanp, SY: {8, 9, "0"},
+	SZ: {8, 8, ""}, TC: nanp, TD: {8, 8, ""}, TG: {8, 8, ""}, TH: {8, 9, "0"}, TJ: {9, 9, ""},
+	TK: {4, 7, ""}, TL: {7, 8, ""}, TM: {8, 8, "8"}, TN: {8, 8, ""}, TO: {5, 7, ""}, TR: {10, 10, "0"},
+	TT: nanp, TV: {5, 7, ""}, TW: {8, 9, "0"}, TZ: {9, 9, "0"}, UA: {9, 9, "0"}, UG: {9, 9, "0"},
+	US: nanp, UY: {8, 8, "0"}, UZ: {9, 9, ""}, VA: {6, 11, ""}, VC: nanp, VE: {10, 10, "0"},
+	VG: nanp, VI: nanp, VN: {9, 10, "0"}, VU: {5, 7, ""}, WF: {6, 6, ""}, WS: {5, 7, ""},
+	YE: {7, 9, "0"}, YT: {9, 9, "0"}, ZA: {9, 9, "0"}, ZM: {9, 9, "0"}, ZW: {9, 9, "0"},
+}
+
+// countryCallingCodes holds ITU-T E.164 calling codes of countries without the plus sign.
+var countryCallingCodes = map[CountryCode][]string{
+	AD: {"376"}, AE: {"971"}, AF: {"93"}, AG: {"1"}, AI: {"1"}, AL: {"355"}, AM: {"374"}, AO: {"244"},
+	AQ: {"672"}, AR: {"54"}, AS: {"1"}, AT: {"43"}, AU: {"61"}, AW: {"297"}, AX: {"358"}, AZ: {"994"},
+	BA: {"387"}, BB: {"1"}, BD: {"880"}, BE: {"32"}, BF: {"226"}, BG: {"359"}, BH: {"973"}, BI: {"257"},
+	BJ: {"229"}, BL: {"590"}, BM: {"1"}, BN: {"673"}, BO: {"591"}, BQ: {"599"}, BR: {"55"}, BS: {"1"},
+	BT: {"975"}, BW: {"267"}, BY: {"375"}, BZ: {"501"}, CA: {"1"}, CC: {"61"}, CD: {"243"}, CF: {"236"},
+	CG: {"242"}, CH: {"41"}, CI: {"225"}, CK: {"682"}, CL: {"56"}, CM: {"237"}, CN: {"86"}, CO: {"57"},
+	CR: {"506"}, CU: {"53"}, CV: {"238"}, CW: {"599"}, CX: {"61"}, CY: {"357"}, CZ: {"420"}, DE: {"49"},
+	DJ: {"253"}, DK: {"45"}, DM: {"1"}, DO: {"1"}, DZ: {"213"}, EC: {"593"}, EE: {"372"}, EG: {"20"},
+	EH: {"212"}, ER: {"291"}, ES: {"34"}, ET: {"251"}, FI: {"358"}, FJ: {"679"}, FK: {"500"}, FM: {"691"},
+	FO: {"298"}, FR: {"33"}, GA: {"241"}, GB: {"44"}, GD: {"1"}, GE: {"995"}, GF: {"594"}, GG: {"44"},
+	GH: {"233"}, GI: {"350"}, GL: {"299"}, GM: {"220"}, GN: {"224"}, GP: {"590"}, GQ: {"240"}, GR: {"30"},
+	GS: {"500"}, GT: {"502"}, GU: {"1"}, GW: {"245"}, GY: {"592"}, HK: {"852"}, HN: {"504"}, HR: {"385"},
+	HT: {"509"}, HU: {"36"}, ID: {"62"}, IE: {"353"}, IL: {"972"}, IM: {"44"}, IN: {"91"}, IO: {"246"},
+	IQ: {"964"}, IR: {"98"}, IS: {"354"}, IT: {"39"}, JE: {"44"}, JM: {"1"}, JO: {"962"}, JP: {"81"},
+	KE: {"254"}, KG: {"996"}, KH: {"855"}, KI: {"686"}, KM: {"269"}, KN: {"1"}, KP: {"850"}, KR: {"82"},
+	KW: {"965"}, KY: {"1"}, KZ: {"7"}, LA: {"856"}, LB: {"961"}, LC: {"1"}, LI: {"423"}, LK: {"94"},
+	LR: {"231"}, LS: {"266"}, LT: {"370"}, LU: {"352"}, LV: {"371"}, LY: {"218"}, MA: {"212"}, MC: {"377"},
+	MD: {"373"}, ME: {"382"}, MF: {"590"}, MG: {"261"}, MH: {"692"}, MK: {"389"}, ML: {"223"}, MM: {"95"},
+	MN: {"976"}, MO: {"853"}, MP: {"1"}, MQ: {"596"}, MR: {"222"}, MS: {"1"}, MT: {"356"}, MU: {"230"},
+	MV: {"960"}, MW: {"265"}, MX: {"52"}, MY: {"60"}, MZ: {"258"}, NA: {"264"}, NC: {"687"}, NE: {"227"},
+	NF: {"672"}, NG: {"234"}, NI: {"505"}, NL: {"31"}, NO: {"47"}, NP: {"977"}, NR: {"674"}, NU: {"683"},
+	NZ: {"64"}, OM: {"968"}, PA: {"507"}, PE: {"51"}, PF: {"689"}, PG: {"675"}, PH: {"63"}, PK: {"92"},
+	PL: {"48"}, PM: {"508"}, PN: {"64"}, PR: {"1"}, PS: {"970"}, PT: {"351"}, PW: {"680"}, PY: {"595"},
+	QA: {"974"}, RE: {"262"}, RO: {"40"}, RS: {"381"}, RU: {"7"}, RW: {"250"}, SA: {"966"}, SB: {"677"},
+	SC: {"248"}, SD: {"249"}, SE: {"46"}, SG: {"65"}, SH: {"290", "247"}, SI: {"386"}, SJ: {"47"}, SK: {"421"},
+	SL: {"232"}, SM: {"378"}, SN: {"221"}, SO: {"252"}, SR: {"597"}, SS: {"211"}, ST: {"239"}, SV: {"503"},
+	SX: {"1"}, SY: {"963"}, SZ: {"268"}, TC: {"1"}, TD: {"235"}, TG: {"228"}, TH: {"66"}, TJ: {"992"},
+	TK: {"690"}, TL: {"670"}, TM: {"993"}, TN: {"216"}, TO: {"676"}, TR: {"90"}, TT: {"1"}, TV: {"688"},
+	TW: {"886"}, TZ: {"255"}, UA: {"380"}, UG: {"256"}, US: {"1"}, UY: {"598"}, UZ: {"998"}, VA: {"379", "39"},
+	VC: {"1"}, VE: {"58"}, VG: {"1"}, VI: {"1"}, VN: {"84"}, VU: {"678"}, WF: {"681"}, WS: {"685"},
+	YE: {"967"}, YT: {"262"}, ZA: {"27"}, ZM: {"260"}, ZW: {"263"},
+}
+
+// callingCodesMain holds the main country of the shared calling codes.
+var callingCodesMain = map[string]CountryCode{
+	"1": US, "7": RU, "39": IT, "44": GB, "47": NO, "61": AU, "64": NZ, "212": MA,
+	"262": RE, "358": FI, "500": FK, "590": GP, "599": CW, "672": NF,
+}
+
+// callingCodePrefixes holds national prefixes, including the calling code,
+// which identify the country within the shared calling code.
+var callingCodePrefixes = map[string]CountryCode{
+	// North American Numbering Plan area codes.
+	"1204": CA, "1226": CA, "1236": CA, "1249": CA, "1250": CA, "1257": CA, "1263": CA, "1289": CA,
+	"1306": CA, "1343": CA, "1354": CA, "1365": CA, "1367": CA, "1368": CA, "1382": CA, "1403": CA,
+	"1416": CA, "1418": CA, "1428": CA, "1431": CA, "1437": CA, "1438": CA, "1450": CA, "1460": CA,
+	"1468": CA, "1474": CA, "1506": CA, "1514": CA, "1519": CA, "1548": CA, "1579": CA, "1581": CA,
+	"1584": CA, "1587": CA, "1600": CA, "1604": CA, "1613": CA, "1622": CA, "1639": CA, "1647": CA,
+	"1672": CA, "1683": CA, "1705": CA, "1709": CA, "1742": CA, "1753": CA, "1778": CA, "1780": CA,
+	"1782": CA, "1807": CA, "1819": CA, "1825": CA, "1867": CA, "1873": CA, "1879": CA, "1902": CA,
+	"1905": CA, "1942": CA,
+	"1242": BS, "1246": BB, "1264": AI, "1268": AG, "1284": VG, "1340": VI, "1345": KY, "1441": BM,
+	"1473": GD, "1649": TC, "1658": JM, "1664": MS, "1670": MP, "1671": GU, "1684": AS, "1721": SX,
+	"1758": LC, "1767": DM, "1784": VC, "1787": PR, "1809": DO, "1829": DO, "1849": DO, "1868": TT,
+	"1869": KN, "1876": JM, "1939": PR,
+
+	// Kazakhstan within +7.
+	"76": KZ, "77": KZ,
+
+	// Crown Dependencies within +44.
+	"441481": GG, "447781": GG, "447839": GG, "447911": GG,
+	"441534": JE, "447509": JE, "447700": JE, "447797": JE, "447829": JE, "447937": JE,
+	"441624": IM, "447524": IM, "447624": IM, "447924": IM,
+
+	// Other shared calling codes.
+	"3906698": VA,
+	"35818":   AX,
+	"4779":    SJ,
+	"6189162": CC, "6189164": CX,
+	"2125288": EH, "2125289": EH,
+	"262269": YT, "262639": YT,
+	"5993": BQ, "5994": BQ, "5997": BQ,
+	"6721": AQ, "6723": NF,
+}
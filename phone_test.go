@@ -0,0 +1,226 @@
+package isocodes
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestCountryCode_CallingCodes(t *testing.T) {
+	type tcase struct {
+		code CountryCode
+		want []string
+	}
+
+	tests := map[string]tcase{
+		"US":   {US, []string{"+1"}},
+		"GB":   {GB, []string{"+44"}},
+		"RU":   {RU, []string{"+7"}},
+		"SH":   {SH, []string{"+290", "+247"}},
+		"BV":   {BV, nil},
+		"Zero": {UnknownCountry, nil},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := tc.code.CallingCodes(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("CallingCodes() got = %v, want %v", got, tc.want)
+			}
+		})
+	}
+
+	t.Run("Coverage", func(t *testing.T) {
+		for c := range countryCallingCodes {
+			if !c.IsValid() {
+				t.Errorf("calling codes defined for invalid country %d", c)
+			}
+		}
+
+		for prefix, c := range callingCodePrefixes {
+			if _, ok := callingCodeOf(prefix); !ok {
+				t.Errorf("prefix %s of %v doesn't start with a calling code", prefix, c)
+			}
+		}
+	})
+}
+
+func TestCountriesForCallingCode(t *testing.T) {
+	type tcase struct {
+		code string
+		want []CountryCode
+	}
+
+	tests := map[string]tcase{
+		"+7":   {"+7", []CountryCode{RU, KZ}},
+		"44":   {"44", []CountryCode{GB, GG, IM, JE}},
+		"+49":  {"+49", []CountryCode{DE}},
+		"+999": {"+999", nil},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := CountriesForCallingCode(tc.code); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("CountriesForCallingCode() got = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCountriesForPhoneNumber(t *testing.T) {
+	type tcase struct {
+		number  string
+		want    []CountryCode
+		wantErr error
+	}
+
+	tests := map[string]tcase{
+		"US":             {"+1 212 555 0100", []CountryCode{US}, nil},
+		"BS":             {"+1 (242) 555-0100", []CountryCode{BS}, nil},
+		"CA":             {"+1-416-555-0100", []CountryCode{CA}, nil},
+		"RU":             {"+7 912 345 67 89", []CountryCode{RU}, nil},
+		"KZ":             {"+7 701 234 56 78", []CountryCode{KZ}, nil},
+		"GB":             {"+44 20 7946 0958", []CountryCode{GB}, nil},
+		"JE":             {"+44 1534 123456", []CountryCode{JE}, nil},
+		"VA":             {"+39 06 698 12345", []CountryCode{VA}, nil},
+		"VA_379":         {"+379 123 4567", []CountryCode{VA}, nil},
+		"IT":             {"+39 06 1234 5678", []CountryCode{IT}, nil},
+		"DE":             {"+49 30 123456", []CountryCode{DE}, nil},
+		"Ambiguous":      {"+590 590 12 34 56", []CountryCode{GP, BL, MF}, nil},
+		"AllPrefixed":    {"+672 5 12345", []CountryCode{NF, AQ}, nil},
+		"NoPlus":         {"12125550100", nil, ErrInvalidPhoneNumber},
+		"Letters":        {"+1 800 FLOWERS", nil, ErrInvalidPhoneNumber},
+		"TooShort":       {"+4912", nil, ErrInvalidPhoneNumber},
+		"TooLong":        {"+4912345678901234", nil, ErrInvalidPhoneNumber},
+		"UnknownCalling": {"+999 1234567", nil, ErrUnknownCallingCode},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := CountriesForPhoneNumber(tc.number)
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Errorf("CountriesForPhoneNumber() error = %v, wantErr %v", err, tc.wantErr)
+				}
+
+				var perr *ParseError
+				if !errors.As(err, &perr) || perr.Input != tc.number || perr.Format != FormatE164 {
+					t.Errorf("CountriesForPhoneNumber() error = %#v, want *ParseError", err)
+				}
+			} else {
+				if !reflect.DeepEqual(got, tc.want) {
+					t.Errorf("CountriesForPhoneNumber() got = %v, want %v", got, tc.want)
+				}
+			}
+		})
+	}
+
+	t.Run("ErrorMessage", func(t *testing.T) {
+		_, err := CountriesForPhoneNumber("+999 1234567")
+
+		want := `unknown calling code: "+999 1234567" is not a valid phone number in E.164 format`
+		if err == nil || err.Error() != want {
+			t.Errorf("Error() got = %v, want %v", err, want)
+		}
+	})
+}
+
+func TestNormalizePhoneNumber(t *testing.T) {
+	type tcase struct {
+		number  string
+		country CountryCode
+		want    string
+		wantErr error
+	}
+
+	tests := map[string]tcase{
+		"GB_National":       {"020 7946 0958", GB, "+442079460958", nil},
+		"GB_International":  {"+44 (0)20 7946 0958", GB, "+442079460958", nil},
+		"GB_IDD":            {"0044 20 7946 0958", UnknownCountry, "+442079460958", nil},
+		"DE_National":       {"030 123456", DE, "+4930123456", nil},
+		"DE_Mobile":         {"0151/23456789", DE, "+4915123456789", nil},
+		"US_National":       {"(212) 555-0100", US, "+12125550100", nil},
+		"US_Trunk":          {"1 212 555 0100", US, "+12125550100", nil},
+		"RU_Trunk":          {"8 912 345-67-89", RU, "+79123456789", nil},
+		"RU_TollFree":       {"800 123 45 67", RU, "+78001234567", nil},
+		"IT_LeadingZero":    {"06 1234 5678", IT, "+390612345678", nil},
+		"HU_Trunk":          {"06 1 234 5678", HU, "+3612345678", nil},
+		"KZ_International":  {"+7 701 234 56 78", KZ, "+77012345678", nil},
+		"ErrCountry":        {"+7 912 345 67 89", KZ, "", ErrPhoneNumberCountry},
+		"ErrLength":         {"020 7946 09", GB, "", ErrPhoneNumberLength},
+		"ErrLengthE164":     {"+1 212 555 01001", UnknownCountry, "", ErrPhoneNumberLength},
+		"ErrNoCountry":      {"020 7946 0958", UnknownCountry, "", ErrInvalidPhoneNumber},
+		"ErrLetters":        {"020 7946 CALL", GB, "", ErrInvalidPhoneNumber},
+		"ErrUnknownCalling": {"+999 123 4567", UnknownCountry, "", ErrUnknownCallingCode},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := NormalizePhoneNumber(tc.number, tc.country)
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Errorf("NormalizePhoneNumber() error = %v, wantErr %v", err, tc.wantErr)
+				}
+
+				var perr *ParseError
+				if !errors.As(err, &perr) || perr.Input != tc.number {
+					t.Errorf("NormalizePhoneNumber() error = %#v, want *ParseError", err)
+				}
+			} else {
+				if err != nil || got != tc.want {
+					t.Errorf("NormalizePhoneNumber() got = %v, %v, want %v", got, err, tc.want)
+				}
+			}
+		})
+	}
+}
+
+func TestValidatePhoneNumber(t *testing.T) {
+	type tcase struct {
+		e164    string
+		want    CountryCode
+		wantErr error
+	}
+
+	tests := map[string]tcase{
+		"GB":        {"+442079460958", GB, nil},
+		"BS":        {"+12425550100", BS, nil},
+		"Ambiguous": {"+590590123456", GP, nil},
+		"ErrLength": {"+4420794609", 0, ErrPhoneNumberLength},
+		"ErrFormat": {"442079460958", 0, ErrInvalidPhoneNumber},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := ValidatePhoneNumber(tc.e164)
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Errorf("ValidatePhoneNumber() error = %v, wantErr %v", err, tc.wantErr)
+				}
+			} else {
+				if err != nil || got != tc.want {
+					t.Errorf("ValidatePhoneNumber() got = %v, %v, want %v", got, err, tc.want)
+				}
+			}
+		})
+	}
+}
+
+func TestCountryCode_PhoneNumbering(t *testing.T) {
+	if minLen, maxLen := GB.PhoneNumberLength(); minLen != 9 || maxLen != 10 {
+		t.Errorf("PhoneNumberLength() got = %d, %d, want 9, 10", minLen, maxLen)
+	}
+
+	if got := DE.TrunkPrefix(); got != "0" {
+		t.Errorf("TrunkPrefix() got = %v, want 0", got)
+	}
+
+	if got := IT.TrunkPrefix(); got != "" {
+		t.Errorf("TrunkPrefix() got = %v, want empty", got)
+	}
+
+	for c := range countryCallingCodes {
+		if _, ok := countryPhoneNumbering[c]; !ok {
+			t.Errorf("country %v has calling codes, but no numbering plan", c)
+		}
+	}
+}
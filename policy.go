@@ -0,0 +1,529 @@
+package isocodes
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync/atomic"
+)
+
+// PolicyEffect represents the effect of the policy rule.
+type PolicyEffect string
+
+const (
+	// PolicyAllow allows requests matched by the rule.
+	PolicyAllow PolicyEffect = "allow"
+
+	// PolicyDeny denies requests matched by the rule.
+	PolicyDeny PolicyEffect = "deny"
+)
+
+// PolicyConfig represents the configuration of the Policy.
+type PolicyConfig struct {
+	// Default holds the effect for requests which aren't matched by any rule.
+	// If empty, PolicyDeny is used.
+	Default PolicyEffect `json:"default,omitempty"`
+
+	// Rules holds rules evaluated in order, the first matching rule wins.
+	Rules []PolicyRule `json:"rules"`
+}
+
+// PolicyRule represents a rule of the Policy. A rule matches a request
+// when all of its non-empty conditions match the request.
+type PolicyRule struct {
+	// Name identifies the rule in decisions.
+	Name string `json:"name"`
+
+	// Effect holds the effect of the rule.
+	Effect PolicyEffect `json:"effect"`
+
+	// Countries holds a country set expression, e.g. "EU - HU",
+	// see ParseCountrySet for the syntax.
+	Countries string `json:"countries,omitempty"`
+
+	// Subdivisions holds ISO 3166-2 subdivision codes, e.g. "US-CA",
+	// which match requests only with the country of the subdivision.
+	Subdivisions []string `json:"subdivisions,omitempty"`
+
+	// Currencies holds currency codes.
+	Currencies []string `json:"currencies,omitempty"`
+}
+
+// PolicyRequest represents a request evaluated by the Policy.
+// Empty fields match only rules without the related condition.
+type PolicyRequest struct {
+	Country     CountryCode  `json:"country"`
+	Subdivision string       `json:"subdivision,omitempty"`
+	Currency    CurrencyCode `json:"currency"`
+}
+
+// PolicyDecision represents the explainable result of the policy evaluation.
+type PolicyDecision struct {
+	// Effect holds the effect of the matched rule or the default effect.
+	Effect PolicyEffect `json:"effect"`
+
+	// Rule holds the name of the matched rule, empty if no rule matched.
+	Rule string `json:"rule,omitempty"`
+
+	// RuleIndex holds 0-based index of the matched rule, -1 if no rule matched.
+	RuleIndex int `json:"ruleIndex"`
+
+	// Reason describes why the decision was made.
+	Reason string `json:"reason"`
+}
+
+// Allowed reports whether the request is allowed.
+func (d PolicyDecision) Allowed() bool { return d.Effect == PolicyAllow }
+
+// Policy represents a compiled set of ordered allow and deny rules.
+// Policy is immutable and safe for concurrent use.
+type Policy struct {
+	config PolicyConfig
+	rules  []policyRule
+}
+
+// policyRule represents the compiled PolicyRule.
+type policyRule struct {
+	anyCountry   bool
+	countries    CountrySet
+	subdivisions map[string]CountryCode
+	currencies   CurrencySet
+}
+
+// NewPolicy compiles the policy configuration.
+// Invalid configurations cause an error which wraps ErrInvalidPolicy.
+func NewPolicy(cfg PolicyConfig) (*Policy, error) {
+	if cfg.Default == "" {
+		cfg.Default = PolicyDeny
+	}
+
+	if !cfg.Default.isValid() {
+		return nil, fmt.Errorf("%w: invalid default effect %q", ErrInvalidPolicy, cfg.Default)
+	}
+
+	p := &Policy{config: cfg, rules: make([]policyRule, len(cfg.Rules))}
+	p.config.Rules = append([]PolicyRule(nil), cfg.Rules...)
+
+	for i, r := range cfg.Rules {
+		if !r.Effect.isValid() {
+			return nil, fmt.Errorf("%w: rule %d %q: invalid effect %q", ErrInvalidPolicy, i, r.Name, r.Effect)
+		}
+
+		compiled := policyRule{anyCountry: strings.TrimSpace(r.Countries) == ""}
+
+		if !compiled.anyCountry {
+			countries, err := ParseCountrySet(r.Countries)
+			if err != nil {
+				return nil, fmt.Errorf("%w: rule %d %q: %s", ErrInvalidPolicy, i, r.Name, err)
+			}
+
+			compiled.countries = countries
+		}
+
+		if len(r.Subdivisions) > 0 {
+			compiled.subdivisions = make(map[string]CountryCode, len(r.Subdivisions))
+
+			for _, s := range r.Subdivisions {
+				code := strings.ToUpper(strings.TrimSpace(s))
+
+				country, ok := subdivisionCountry(code)
+				if !ok {
+					return nil, fmt.Errorf("%w: rule %d %q: invalid subdivision code %q", ErrInvalidPolicy, i, r.Name, s)
+				}
+
+				compiled.subdivisions[code] = country
+			}
+		}
+
+		for _, code := range r.Currencies {
+			c, err := StringToCurrencyCode(strings.TrimSpace(code))
+			if err != nil {
+				return nil, fmt.Errorf("%w: rule %d %q: %s", ErrInvalidPolicy, i, r.Name, err)
+			}
+
+			compiled.currencies.Add(c)
+		}
+
+		p.rules[i] = compiled
+	}
+
+	return p, nil
+}
+
+// ParsePolicy parses the policy configuration in JSON
+// or in the YAML subset format and compiles it. JSON is detected
+// by the leading '{'. The YAML subset supports comments, quoted
+// and plain scalars, flow lists like [EUR, USD] and block lists:
+//
+//	default: deny
+//	rules:
+//	  - name: sanctions
+//	    effect: deny
+//	    countries: RU, BY
+//	  - name: eu-shipping
+//	    effect: allow
+//	    countries: EU - HU
+//	    currencies: [EUR]
+func ParsePolicy(data []byte) (*Policy, error) {
+	var cfg PolicyConfig
+
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '{' {
+		decoder := json.NewDecoder(bytes.NewReader(trimmed))
+		decoder.DisallowUnknownFields()
+
+		if err := decoder.Decode(&cfg); err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidPolicy, err)
+		}
+	} else {
+		var err error
+		if cfg, err = parsePolicyYAML(string(data)); err != nil {
+			return nil, err
+		}
+	}
+
+	return NewPolicy(cfg)
+}
+
+// Config returns a copy of the policy configuration.
+func (p *Policy) Config() PolicyConfig {
+	cfg := p.config
+	cfg.Rules = append([]PolicyRule(nil), p.config.Rules...)
+
+	return cfg
+}
+
+// Evaluate evaluates rules in order and returns the decision
+// of the first matching rule or the default decision.
+func (p *Policy) Evaluate(req PolicyRequest) PolicyDecision {
+	for i, r := range p.rules {
+		if !r.matches(req) {
+			continue
+		}
+
+		rule := p.config.Rules[i]
+
+		return PolicyDecision{
+			Effect:    rule.Effect,
+			Rule:      rule.Name,
+			RuleIndex: i,
+			Reason:    fmt.Sprintf("rule %d %q %s %s", i, rule.Name, rule.Effect.verb(), req.describe()),
+		}
+	}
+
+	return PolicyDecision{
+		Effect:    p.config.Default,
+		RuleIndex: -1,
+		Reason:    fmt.Sprintf("no rule matched %s, default effect is %s", req.describe(), p.config.Default),
+	}
+}
+
+// matches reports whether the rule matches the request.
+func (r policyRule) matches(req PolicyRequest) bool {
+	if !r.anyCountry && !r.countries.Contains(req.Country) {
+		return false
+	}
+
+	if r.subdivisions != nil {
+		// The subdivision must belong to the requested country.
+		if country, ok := r.subdivisions[strings.ToUpper(req.Subdivision)]; !ok || country != req.Country {
+			return false
+		}
+	}
+
+	if !r.currencies.IsEmpty() && !r.currencies.Contains(req.Currency) {
+		return false
+	}
+
+	return true
+}
+
+// subdivisionCountry returns the country of the ISO 3166-2 subdivision
+// code, e.g. "US-CA", which is the alpha-2 country code followed by
+// up to three alphanumeric characters.
+func subdivisionCountry(code string) (CountryCode, bool) {
+	country, sub, ok := strings.Cut(code, "-")
+	if !ok || len(country) != 2 || sub == "" || len(sub) > 3 || !isAlphanumeric(sub) {
+		return UnknownCountry, false
+	}
+
+	c, err := StringToCountryCode(country)
+	if err != nil {
+		return UnknownCountry, false
+	}
+
+	return c, true
+}
+
+// describe returns the description of the request for decision reasons.
+func (req PolicyRequest) describe() string {
+	parts := make([]string, 0, 3)
+
+	if !req.Country.IsZero() {
+		parts = append(parts, "country "+req.Country.String())
+	}
+
+	if req.Subdivision != "" {
+		parts = append(parts, "subdivision "+strings.ToUpper(req.Subdivision))
+	}
+
+	if !req.Currency.IsZero() {
+		parts = append(parts, "currency "+req.Currency.String())
+	}
+
+	if len(parts) == 0 {
+		return "empty request"
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// isValid reports whether the effect is known.
+func (e PolicyEffect) isValid() bool { return e == PolicyAllow || e == PolicyDeny }
+
+// verb returns the effect in the third person used in decision reasons.
+func (e PolicyEffect) verb() string {
+	if e == PolicyDeny {
+		return "denies"
+	}
+
+	return "allows"
+}
+
+// PolicyEngine evaluates requests against the current Policy,
+// which can be replaced at any time without locking evaluations.
+// PolicyEngine is safe for concurrent use.
+type PolicyEngine struct {
+	policy atomic.Pointer[Policy]
+}
+
+// denyPolicy denies every request. It is used by PolicyEngine
+// when no policy is set.
+var denyPolicy = &Policy{config: PolicyConfig{Default: PolicyDeny}}
+
+// NewPolicyEngine returns PolicyEngine with the policy.
+// A nil policy denies every request.
+func NewPolicyEngine(p *Policy) *PolicyEngine {
+	e := &PolicyEngine{}
+	e.Store(p)
+
+	return e
+}
+
+// Policy returns the current policy.
+func (e *PolicyEngine) Policy() *Policy {
+	if p := e.policy.Load(); p != nil {
+		return p
+	}
+
+	return denyPolicy
+}
+
+// Store replaces the current policy.
+// A nil policy denies every request.
+func (e *PolicyEngine) Store(p *Policy) {
+	if p == nil {
+		p = denyPolicy
+	}
+
+	e.policy.Store(p)
+}
+
+// Reload parses the policy configuration like ParsePolicy and replaces
+// the current policy. On error the current policy is kept.
+func (e *PolicyEngine) Reload(data []byte) error {
+	p, err := ParsePolicy(data)
+	if err != nil {
+		return err
+	}
+
+	e.policy.Store(p)
+
+	return nil
+}
+
+// Evaluate evaluates the request against the current policy.
+func (e *PolicyEngine) Evaluate(req PolicyRequest) PolicyDecision { return e.Policy().Evaluate(req) }
+
+// parsePolicyYAML parses the YAML subset of the policy configuration.
+func parsePolicyYAML(data string) (PolicyConfig, error) {
+	var (
+		cfg     PolicyConfig
+		inRules bool
+		rule    *PolicyRule
+		listKey string
+		// listIndent holds the indentation of the key which opened
+		// the block list, items must be indented deeper.
+		listIndent int
+	)
+
+	lineErr := func(n int, format string, args ...any) error {
+		return fmt.Errorf("%w: line %d: %s", ErrInvalidPolicy, n, fmt.Sprintf(format, args...))
+	}
+
+	for i, line := range strings.Split(data, "\n") {
+		n := i + 1
+		line = strings.TrimRight(stripYAMLComment(line), " \t\r")
+
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		indent := len(line) - len(strings.TrimLeft(line, " "))
+		text := strings.TrimSpace(line)
+
+		if indent == 0 {
+			key, value, ok := splitYAMLPair(text)
+			if !ok {
+				return cfg, lineErr(n, "expected key: value, got %q", text)
+			}
+
+			switch key {
+			case "default":
+				cfg.Default = PolicyEffect(unquoteYAML(value))
+				inRules = false
+			case "rules":
+				if value != "" && value != "[]" {
+					return cfg, lineErr(n, "rules must be a list")
+				}
+
+				inRules = true
+			default:
+				return cfg, lineErr(n, "unknown key %q", key)
+			}
+
+			continue
+		}
+
+		if !inRules {
+			return cfg, lineErr(n, "unexpected indentation")
+		}
+
+		if item, ok := cutPrefix(text, "- "); ok && listKey != "" && indent > listIndent {
+			if err := setPolicyRuleList(rule, listKey, []string{unquoteYAML(item)}); err != nil {
+				return cfg, lineErr(n, "%s", err)
+			}
+
+			continue
+		}
+
+		listKey = ""
+		keyIndent := indent
+
+		if item, ok := cutPrefix(text, "-"); ok {
+			cfg.Rules = append(cfg.Rules, PolicyRule{})
+			rule = &cfg.Rules[len(cfg.Rules)-1]
+			text = strings.TrimLeft(item, " ")
+			keyIndent += len(item) - len(text) + 1
+		}
+
+		if rule == nil {
+			return cfg, lineErr(n, "expected list item starting with -")
+		}
+
+		key, value, ok := splitYAMLPair(text)
+		if !ok {
+			return cfg, lineErr(n, "expected key: value, got %q", text)
+		}
+
+		switch key {
+		case "name":
+			rule.Name = unquoteYAML(value)
+		case "effect":
+			rule.Effect = PolicyEffect(unquoteYAML(value))
+		case "countries":
+			rule.Countries = unquoteYAML(value)
+		case "subdivisions", "currencies":
+			if value == "" {
+				listKey, listIndent = key, keyIndent
+
+				continue
+			}
+
+			if err := setPolicyRuleList(rule, key, parseYAMLList(value)); err != nil {
+				return cfg, lineErr(n, "%s", err)
+			}
+		default:
+			return cfg, lineErr(n, "unknown rule key %q", key)
+		}
+	}
+
+	return cfg, nil
+}
+
+// setPolicyRuleList appends values to the list field of the rule.
+func setPolicyRuleList(rule *PolicyRule, key string, values []string) error {
+	switch key {
+	case "subdivisions":
+		rule.Subdivisions = append(rule.Subdivisions, values...)
+	case "currencies":
+		rule.Currencies = append(rule.Currencies, values...)
+	default:
+		return fmt.Errorf("%q is not a list", key)
+	}
+
+	return nil
+}
+
+// splitYAMLPair splits "key: value" pair.
+func splitYAMLPair(s string) (key, value string, ok bool) {
+	i := strings.Index(s, ":")
+	if i <= 0 {
+		return "", "", false
+	}
+
+	return strings.TrimSpace(s[:i]), strings.TrimSpace(s[i+1:]), true
+}
+
+// parseYAMLList parses flow list "[A, B]" or comma separated plain scalars.
+func parseYAMLList(s string) []string {
+	s = strings.TrimSuffix(strings.TrimPrefix(s, "["), "]")
+
+	var values []string
+
+	for _, v := range strings.Split(s, ",") {
+		if v = unquoteYAML(strings.TrimSpace(v)); v != "" {
+			values = append(values, v)
+		}
+	}
+
+	return values
+}
+
+// unquoteYAML removes surrounding single or double quotes of the scalar.
+func unquoteYAML(s string) string {
+	if len(s) >= 2 && (s[0] == '"' || s[0] == '\'') && s[len(s)-1] == s[0] {
+		return s[1 : len(s)-1]
+	}
+
+	return s
+}
+
+// stripYAMLComment removes the comment which starts with # outside of quotes.
+func stripYAMLComment(line string) string {
+	var quote byte
+
+	for i := 0; i < len(line); i++ {
+		switch c := line[i]; {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '#' && (i == 0 || line[i-1] == ' ' || line[i-1] == '\t'):
+			return line[:i]
+		}
+	}
+
+	return line
+}
+
+// cutPrefix is like strings.CutPrefix, which requires Go 1.20.
+func cutPrefix(s, prefix string) (string, bool) {
+	if !strings.HasPrefix(s, prefix) {
+		return s, false
+	}
+
+	return s[len(prefix):], true
+}
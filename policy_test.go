@@ -0,0 +1,281 @@
+package isocodes
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestPolicy_Evaluate(t *testing.T) {
+	policy, err := NewPolicy(PolicyConfig{
+		Default: PolicyDeny,
+		Rules: []PolicyRule{
+			{Name: "sanctions", Effect: PolicyDeny, Countries: "RU, BY"},
+			{Name: "crimea", Effect: PolicyDeny, Subdivisions: []string{"UA-43"}},
+			{Name: "no-rub", Effect: PolicyDeny, Currencies: []string{"RUB"}},
+			{Name: "eu", Effect: PolicyAllow, Countries: "EU - HU"},
+			{Name: "us-usd", Effect: PolicyAllow, Countries: "US", Currencies: []string{"USD"}},
+			{Name: "ukraine", Effect: PolicyAllow, Countries: "UA"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewPolicy() error = %v", err)
+	}
+
+	type tcase struct {
+		req       PolicyRequest
+		want      PolicyEffect
+		wantRule  string
+		wantIndex int
+	}
+
+	tests := map[string]tcase{
+		"Sanctioned":         {PolicyRequest{Country: RU}, PolicyDeny, "sanctions", 0},
+		"Subdivision":        {PolicyRequest{Country: UA, Subdivision: "ua-43"}, PolicyDeny, "crimea", 1},
+		"OtherSubdivision":   {PolicyRequest{Country: UA, Subdivision: "UA-30"}, PolicyAllow, "ukraine", 5},
+		"ForeignSubdivision": {PolicyRequest{Country: FR, Subdivision: "UA-43"}, PolicyAllow, "eu", 3},
+		"Currency":           {PolicyRequest{Country: DE, Currency: RUB}, PolicyDeny, "no-rub", 2},
+		"EU":                 {PolicyRequest{Country: DE, Currency: EUR}, PolicyAllow, "eu", 3},
+		"ExcludedFromGroup":  {PolicyRequest{Country: HU}, PolicyDeny, "", -1},
+		"CountryCurrency":    {PolicyRequest{Country: US, Currency: USD}, PolicyAllow, "us-usd", 4},
+		"WrongCurrency":      {PolicyRequest{Country: US, Currency: EUR}, PolicyDeny, "", -1},
+		"NoCurrency":         {PolicyRequest{Country: US}, PolicyDeny, "", -1},
+		"Empty":              {PolicyRequest{}, PolicyDeny, "", -1},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := policy.Evaluate(tc.req)
+			if got.Effect != tc.want || got.Rule != tc.wantRule || got.RuleIndex != tc.wantIndex {
+				t.Errorf("Evaluate() got = %+v, want %v by %q (%d)", got, tc.want, tc.wantRule, tc.wantIndex)
+			}
+
+			if got.Allowed() != (tc.want == PolicyAllow) {
+				t.Errorf("Allowed() got = %v, want %v", got.Allowed(), tc.want == PolicyAllow)
+			}
+
+			if got.Reason == "" {
+				t.Errorf("Evaluate() got empty reason")
+			}
+		})
+	}
+
+	t.Run("Reason", func(t *testing.T) {
+		want := `rule 0 "sanctions" denies country RU`
+		if got := policy.Evaluate(PolicyRequest{Country: RU}).Reason; got != want {
+			t.Errorf("Reason got = %q, want %q", got, want)
+		}
+	})
+}
+
+func TestNewPolicy(t *testing.T) {
+	type tcase struct {
+		cfg     PolicyConfig
+		wantErr error
+	}
+
+	tests := map[string]tcase{
+		"Valid":                     {PolicyConfig{Rules: []PolicyRule{{Name: "a", Effect: PolicyAllow, Countries: "*"}}}, nil},
+		"DefaultDeny":               {PolicyConfig{}, nil},
+		"InvalidDefault":            {PolicyConfig{Default: "maybe"}, ErrInvalidPolicy},
+		"InvalidEffect":             {PolicyConfig{Rules: []PolicyRule{{Name: "a", Effect: "block"}}}, ErrInvalidPolicy},
+		"InvalidCountries":          {PolicyConfig{Rules: []PolicyRule{{Name: "a", Effect: PolicyDeny, Countries: "QQ"}}}, ErrInvalidPolicy},
+		"InvalidCurrency":           {PolicyConfig{Rules: []PolicyRule{{Name: "a", Effect: PolicyDeny, Currencies: []string{"QQQ"}}}}, ErrInvalidPolicy},
+		"InvalidSubdivision":        {PolicyConfig{Rules: []PolicyRule{{Name: "a", Effect: PolicyDeny, Subdivisions: []string{"CA"}}}}, ErrInvalidPolicy},
+		"UnknownSubdivisionCountry": {PolicyConfig{Rules: []PolicyRule{{Name: "a", Effect: PolicyDeny, Subdivisions: []string{"QQ-1"}}}}, ErrInvalidPolicy},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewPolicy(tc.cfg)
+			if !errors.Is(err, tc.wantErr) || (tc.wantErr == nil && err != nil) {
+				t.Errorf("NewPolicy() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestParsePolicy(t *testing.T) {
+	const yaml = `
+# shipping policy
+default: "allow"
+rules:
+  - name: sanctions   # comment
+    effect: deny
+    countries: "RU, BY"
+  - name: "no #crypto"
+    effect: deny
+    currencies: [XAU, RUB]
+  - name: crimea
+    effect: deny
+    subdivisions:
+      - UA-43
+      - 'UA-40'
+`
+
+	const json = `{
+		"default": "allow",
+		"rules": [
+			{"name": "sanctions", "effect": "deny", "countries": "RU, BY"},
+			{"name": "no #crypto", "effect": "deny", "currencies": ["RUB"]},
+			{"name": "crimea", "effect": "deny", "subdivisions": ["UA-43", "UA-40"]}
+		]
+	}`
+
+	type tcase struct {
+		data    string
+		wantErr error
+	}
+
+	tests := map[string]tcase{
+		"YAML":              {yaml, nil},
+		"JSON":              {json, nil},
+		"JSONUnknownField":  {`{"rules": [{"name": "a", "effect": "deny", "country": "RU"}]}`, ErrInvalidPolicy},
+		"YAMLUnknownKey":    {"default: deny\nrulez:\n", ErrInvalidPolicy},
+		"YAMLUnknownRule":   {"rules:\n  - name: a\n    country: RU\n", ErrInvalidPolicy},
+		"YAMLNoListItem":    {"rules:\n  name: a\n", ErrInvalidPolicy},
+		"YAMLNoRules":       {"default: deny\n  - name: a\n", ErrInvalidPolicy},
+		"YAMLInvalidEffect": {"rules:\n  - name: a\n    effect: block\n", ErrInvalidPolicy},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			p, err := ParsePolicy([]byte(tc.data))
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Errorf("ParsePolicy() error = %v, wantErr %v", err, tc.wantErr)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ParsePolicy() error = %v", err)
+			}
+
+			cfg := p.Config()
+			if cfg.Default != PolicyAllow || len(cfg.Rules) != 3 || cfg.Rules[1].Name != "no #crypto" {
+				t.Fatalf("Config() got = %+v", cfg)
+			}
+
+			checks := map[PolicyRequest]string{
+				{Country: BY}:                       "sanctions",
+				{Country: DE, Currency: RUB}:        "no #crypto",
+				{Country: UA, Subdivision: "UA-40"}: "crimea",
+				{Country: UA, Subdivision: "UA-30"}: "",
+			}
+
+			for req, want := range checks {
+				if got := p.Evaluate(req); got.Rule != want {
+					t.Errorf("Evaluate(%+v) got = %+v, want rule %q", req, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestParsePolicy_BlockList(t *testing.T) {
+	const yaml = `
+rules:
+  - name: a
+    effect: deny
+    currencies:
+      - RUB
+  - name: b
+    effect: allow
+    subdivisions:
+      - US-CA
+  -   name: c
+      effect: deny
+      currencies:
+        - BYR
+        - XAU
+`
+
+	p, err := ParsePolicy([]byte(yaml))
+	if err != nil {
+		t.Fatalf("ParsePolicy() error = %v", err)
+	}
+
+	want := []PolicyRule{
+		{Name: "a", Effect: PolicyDeny, Currencies: []string{"RUB"}},
+		{Name: "b", Effect: PolicyAllow, Subdivisions: []string{"US-CA"}},
+		{Name: "c", Effect: PolicyDeny, Currencies: []string{"BYR", "XAU"}},
+	}
+
+	if got := p.Config().Rules; !reflect.DeepEqual(got, want) {
+		t.Errorf("Config().Rules got = %+v, want %+v", got, want)
+	}
+}
+
+func TestPolicyEngine_NilPolicy(t *testing.T) {
+	engines := map[string]*PolicyEngine{
+		"New":   NewPolicyEngine(nil),
+		"Store": NewPolicyEngine(mustParsePolicy(t, "default: allow\n")),
+		"Zero":  {},
+	}
+
+	engines["Store"].Store(nil)
+
+	for name, engine := range engines {
+		t.Run(name, func(t *testing.T) {
+			if got := engine.Evaluate(PolicyRequest{Country: DE}); got.Effect != PolicyDeny || got.RuleIndex != -1 {
+				t.Errorf("Evaluate() got = %+v, want default deny", got)
+			}
+		})
+	}
+}
+
+func TestPolicyEngine(t *testing.T) {
+	engine := NewPolicyEngine(mustParsePolicy(t, "default: allow\n"))
+
+	if !engine.Evaluate(PolicyRequest{Country: RU}).Allowed() {
+		t.Fatalf("Evaluate() got denied, want allowed")
+	}
+
+	if err := engine.Reload([]byte("rules:\n  - name: a\n    effect: nope\n")); !errors.Is(err, ErrInvalidPolicy) {
+		t.Fatalf("Reload() error = %v, wantErr %v", err, ErrInvalidPolicy)
+	}
+
+	if !engine.Evaluate(PolicyRequest{Country: RU}).Allowed() {
+		t.Fatalf("Evaluate() after failed reload got denied, want allowed")
+	}
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for j := 0; j < 100; j++ {
+				engine.Evaluate(PolicyRequest{Country: RU, Currency: RUB})
+			}
+		}()
+	}
+
+	for i := 0; i < 10; i++ {
+		if err := engine.Reload([]byte("default: allow\nrules:\n  - name: sanctions\n    effect: deny\n    countries: RU\n")); err != nil {
+			t.Errorf("Reload() error = %v", err)
+		}
+	}
+
+	wg.Wait()
+
+	if got := engine.Evaluate(PolicyRequest{Country: RU}); got.Allowed() || got.Rule != "sanctions" {
+		t.Errorf("Evaluate() after reload got = %+v, want denied by sanctions", got)
+	}
+}
+
+func mustParsePolicy(t *testing.T, data string) *Policy {
+	t.Helper()
+
+	p, err := ParsePolicy([]byte(data))
+	if err != nil {
+		t.Fatalf("ParsePolicy() error = %v", err)
+	}
+
+	return p
+}
@@ -0,0 +1,230 @@
+package isocodes
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// ValidatePostalCode validates case-insensitive postal code of the country
+// and returns it in the canonical form, e.g. "sw1a1aa" is returned as "SW1A 1AA"
+// for GB. Spaces and hyphens of the input are ignored, the canonical
+// separators are inserted according to the country format.
+//
+// For countries without postal codes an empty postal code is valid and any
+// other value causes an error. For countries with unknown format only basic
+// checks are performed and the postal code is returned in upper case.
+//
+// Invalid postal codes cause *ParseError which wraps ErrInvalidPostalCode.
+func ValidatePostalCode(c CountryCode, code string) (string, error) {
+	if !c.IsValid() {
+		return "", postalCodeParseError(c, code)
+	}
+
+	compact := strings.ToUpper(strings.NewReplacer(" ", "", "-", "", "\t", "").Replace(code))
+
+	if !c.HasPostalCodes() {
+		if compact != "" {
+			return "", postalCodeParseError(c, code)
+		}
+
+		return "", nil
+	}
+
+	format, ok := countryPostalCodes[c]
+	if !ok {
+		if !genericPostalCode.MatchString(strings.ToUpper(strings.TrimSpace(code))) {
+			return "", postalCodeParseError(c, code)
+		}
+
+		return strings.Join(strings.Fields(strings.ToUpper(code)), " "), nil
+	}
+
+	if !postalCodePattern(c).MatchString(compact) {
+		return "", postalCodeParseError(c, code)
+	}
+
+	if format.canonical == nil {
+		return compact, nil
+	}
+
+	return format.canonical(compact), nil
+}
+
+// HasPostalCodes reports whether the country uses postal codes.
+// It returns false for UnknownCountry and invalid codes.
+func (c CountryCode) HasPostalCodes() bool {
+	if !c.IsValid() {
+		return false
+	}
+
+	_, ok := countriesWithoutPostalCodes[c]
+
+	return !ok
+}
+
+// PostalCodeExample returns an example of the postal code
+// of the country in the canonical form. It returns an empty string
+// for countries without postal codes or with unknown format.
+func (c CountryCode) PostalCodeExample() string { return countryPostalCodes[c].example }
+
+// postalCodeParseError returns ParseError for the invalid postal code of the country.
+func postalCodeParseError(c CountryCode, input string) *ParseError {
+	return &ParseError{Input: input, Type: CodeTypePostalCode, Format: FormatNational, Country: c, Err: ErrInvalidPostalCode}
+}
+
+// genericPostalCode matches postal codes of countries with unknown format.
+var genericPostalCode = regexp.MustCompile(`^[A-Z0-9][A-Z0-9 -]{1,9}$`)
+
+var (
+	postalCodePatternsOnce sync.Once
+	postalCodePatterns     map[CountryCode]*regexp.Regexp
+)
+
+// postalCodePattern returns compiled pattern of the country postal code.
+func postalCodePattern(c CountryCode) *regexp.Regexp {
+	postalCodePatternsOnce.Do(func() {
+		postalCodePatterns = make(map[CountryCode]*regexp.Regexp, len(countryPostalCodes))
+
+		for country, format := range countryPostalCodes {
+			postalCodePatterns[country] = regexp.MustCompile(`^(?:` + format.pattern + `)$`)
+		}
+	})
+
+	return postalCodePatterns[c]
+}
+
+// postalCodeFormat represents the postal code format of the country.
+type postalCodeFormat struct {
+	// pattern holds the regular expression of the postal code in
+	// upper case without spaces and hyphens.
+	pattern string
+
+	// canonical returns the canonical form of the postal code,
+	// nil means that the canonical form has no separators.
+	canonical func(compact string) string
+
+	// example holds an example of the postal code in the canonical form.
+	example string
+}
+
+// separated returns the canonical func which inserts the separator before the last n characters.
+func separated(sep string, n int) func(string) string {
+	return func(s string) string { return s[:len(s)-n] + sep + s[len(s)-n:] }
+}
+
+// prefixed returns the canonical func which adds the country prefix with hyphen.
+func prefixed(prefix string) func(string) string {
+	return func(s string) string { return prefix + "-" + strings.TrimPrefix(s, prefix) }
+}
+
+// countryPostalCodes holds postal code formats of countries.
+var countryPostalCodes = map[CountryCode]postalCodeFormat{
+	AD: {`AD[1-7]00`, nil, "AD500"},
+	AM: {`[0-9]{4}`, nil, "0010"},
+	AR: {`[A-Z][0-9]{4}[A-Z]{3}|[0-9]{4}`, nil, "C1002AAP"},
+	AT: {`[1-9][0-9]{3}`, nil, "1010"},
+	AU: {`[0-9]{4}`, nil, "2000"},
+	BA: {`[0-9]{5}`, nil, "71000"},
+	BD: {`[0-9]{4}`, nil, "1000"},
+	BE: {`[1-9][0-9]{3}`, nil, "1000"},
+	BG: {`[1-9][0-9]{3}`, nil, "1000"},
+	BR: {`[0-9]{8}`, separated("-", 3), "01310-100"},
+	BY: {`2[0-9]{5}`, nil, "220030"},
+	CA: {`[ABCEGHJ-NPRSTVXY][0-9][ABCEGHJ-NPRSTV-Z][0-9][ABCEGHJ-NPRSTV-Z][0-9]`, separated(" ", 3), "K1A 0B1"},
+	CH: {`[1-9][0-9]{3}`, nil, "8001"},
+	CL: {`[0-9]{7}`, nil, "8320000"},
+	CN: {`[0-9]{6}`, nil, "100000"},
+	CO: {`[0-9]{6}`, nil, "110111"},
+	CR: {`[0-9]{5}`, nil, "10101"},
+	CY: {`[1-9][0-9]{3}`, nil, "1010"},
+	CZ: {`[1-7][0-9]{4}`, separated(" ", 2), "110 00"},
+	DE: {`[0-9]{5}`, nil, "10115"},
+	DK: {`[1-9][0-9]{3}`, nil, "1050"},
+	DO: {`[0-9]{5}`, nil, "10101"},
+	DZ: {`[0-9]{5}`, nil, "16000"},
+	EC: {`[0-9]{6}`, nil, "170150"},
+	EE: {`[0-9]{5}`, nil, "10111"},
+	EG: {`[0-9]{5}`, nil, "11511"},
+	ES: {`(?:0[1-9]|[1-4][0-9]|5[0-2])[0-9]{3}`, nil, "28001"},
+	FI: {`[0-9]{5}`, nil, "00100"},
+	FO: {`[0-9]{3}`, nil, "100"},
+	FR: {`[0-9]{5}`, nil, "75008"},
+	GB: {`[A-Z]{1,2}[0-9][A-Z0-9]?[0-9][ABD-HJLNP-UW-Z]{2}|GIR0AA`, separated(" ", 3), "SW1A 1AA"},
+	GE: {`[0-9]{4}`, nil, "0105"},
+	GL: {`39[0-9]{2}`, nil, "3900"},
+	GR: {`[1-8][0-9]{4}`, separated(" ", 2), "104 31"},
+	GT: {`[0-9]{5}`, nil, "01001"},
+	HR: {`[1-5][0-9]{4}`, nil, "10000"},
+	HU: {`[1-9][0-9]{3}`, nil, "1051"},
+	ID: {`[1-9][0-9]{4}`, nil, "10110"},
+	IE: {`[AC-FHKNPRTV-Y][0-9]{2}[0-9AC-FHKNPRTV-Y]{4}|D6W[0-9AC-FHKNPRTV-Y]{4}`, separated(" ", 4), "D02 X285"},
+	IL: {`[0-9]{7}`, nil, "9614303"},
+	IN: {`[1-9][0-9]{5}`, nil, "110001"},
+	IS: {`[1-9][0-9]{2}`, nil, "101"},
+	IT: {`[0-9]{5}`, nil, "00184"},
+	JP: {`[0-9]{7}`, separated("-", 4), "100-0001"},
+	KE: {`[0-9]{5}`, nil, "00100"},
+	KR: {`[0-9]{5}`, nil, "03187"},
+	KZ: {`[0-9]{6}`, nil, "050000"},
+	LI: {`94(?:8[5-9]|9[0-8])`, nil, "9490"},
+	LK: {`[0-9]{5}`, nil, "00100"},
+	LT: {`(?:LT)?[0-9]{5}`, prefixed("LT"), "LT-01100"},
+	LU: {`[0-9]{4}`, nil, "1111"},
+	LV: {`(?:LV)?[0-9]{4}`, prefixed("LV"), "LV-1050"},
+	MA: {`[0-9]{5}`, nil, "10000"},
+	MC: {`980[0-9]{2}`, nil, "98000"},
+	MD: {`(?:MD)?[0-9]{4}`, prefixed("MD"), "MD-2001"},
+	ME: {`8[0-9]{4}`, nil, "81000"},
+	MK: {`[1-9][0-9]{3}`, nil, "1000"},
+	MT: {`[A-Z]{3}[0-9]{4}`, separated(" ", 4), "VLT 1117"},
+	MX: {`[0-9]{5}`, nil, "06600"},
+	MY: {`[0-9]{5}`, nil, "50450"},
+	NG: {`[0-9]{6}`, nil, "100001"},
+	NL: {`[1-9][0-9]{3}(?:[A-RT-Z][A-Z]|S[BCE-RT-Z])`, separated(" ", 2), "1012 AB"},
+	NO: {`[0-9]{4}`, nil, "0150"},
+	NZ: {`[0-9]{4}`, nil, "6011"},
+	PE: {`[0-9]{5}`, nil, "15001"},
+	PH: {`[0-9]{4}`, nil, "1000"},
+	PK: {`[0-9]{5}`, nil, "44000"},
+	PL: {`[0-9]{5}`, separated("-", 3), "00-950"},
+	PT: {`[1-9][0-9]{6}`, separated("-", 3), "1000-001"},
+	RO: {`[0-9]{6}`, nil, "010011"},
+	RS: {`[0-9]{5}`, nil, "11000"},
+	RU: {`[1-9][0-9]{5}`, nil, "101000"},
+	SA: {`[0-9]{5}`, nil, "11564"},
+	SE: {`[1-9][0-9]{4}`, separated(" ", 2), "113 51"},
+	SG: {`[0-9]{6}`, nil, "018956"},
+	SI: {`[1-9][0-9]{3}`, nil, "1000"},
+	SK: {`[089][0-9]{4}`, separated(" ", 2), "811 01"},
+	SM: {`4789[0-9]`, nil, "47890"},
+	TH: {`[1-9][0-9]{4}`, nil, "10200"},
+	TN: {`[1-9][0-9]{3}`, nil, "1000"},
+	TR: {`[0-9]{5}`, nil, "06100"},
+	TW: {`[0-9]{3}(?:[0-9]{2,3})?`, nil, "100"},
+	UA: {`[0-9]{5}`, nil, "01001"},
+	US: {`[0-9]{5}(?:[0-9]{4})?`, zipCode, "20500"},
+	UY: {`[0-9]{5}`, nil, "11000"},
+	VA: {`00120`, nil, "00120"},
+	VN: {`[0-9]{6}`, nil, "100000"},
+	ZA: {`[0-9]{4}`, nil, "0001"},
+}
+
+// zipCode returns the canonical form of the US ZIP or ZIP+4 code.
+func zipCode(s string) string {
+	if len(s) == 9 {
+		return separated("-", 4)(s)
+	}
+
+	return s
+}
+
+// countriesWithoutPostalCodes holds countries which don't use postal codes.
+var countriesWithoutPostalCodes = map[CountryCode]struct{}{
+	AE: {}, AG: {}, AO: {}, AW: {}, BF: {}, BI: {}, BJ: {}, BO: {}, BS: {}, BW: {},
+	BZ: {}, CD: {}, CF: {}, CG: {}, CI: {}, CK: {}, CM: {}, DJ: {}, DM: {}, ER: {},
+	FJ: {}, GA: {}, GD: {}, GM: {}, GQ: {}, GY: {}, HK: {}, KI: {}, KM: {}, KN: {},
+	KP: {}, ML: {}, MO: {}, MR: {}, MW: {}, NR: {}, NU: {}, QA: {}, RW: {}, SB: {},
+	SC: {}, SL: {}, SR: {}, SS: {}, ST: {}, SY: {}, TF: {}, TG: {}, TK: {}, TL: {},
+	TO: {}, TV: {}, UG: {}, VU: {}, YE: {}, ZW: {},
+}
@@ -0,0 +1,86 @@
+package isocodes
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidatePostalCode(t *testing.T) {
+	type tcase struct {
+		country CountryCode
+		code    string
+		want    string
+		wantErr error
+	}
+
+	tests := map[string]tcase{
+		"GB":             {GB, "sw1a1aa", "SW1A 1AA", nil},
+		"GBSpaced":       {GB, " EC1A  1BB ", "EC1A 1BB", nil},
+		"GBInvalid":      {GB, "SW1A 1A", "", ErrInvalidPostalCode},
+		"CA":             {CA, "k1a0b1", "K1A 0B1", nil},
+		"CAInvalid":      {CA, "D1A 0B1", "", ErrInvalidPostalCode},
+		"NL":             {NL, "1012ab", "1012 AB", nil},
+		"NLInvalid":      {NL, "0123 AB", "", ErrInvalidPostalCode},
+		"DE":             {DE, "10115", "10115", nil},
+		"DEInvalid":      {DE, "1011", "", ErrInvalidPostalCode},
+		"PL":             {PL, "00950", "00-950", nil},
+		"JP":             {JP, "100-0001", "100-0001", nil},
+		"US":             {US, "20500", "20500", nil},
+		"USZip4":         {US, "205000003", "20500-0003", nil},
+		"LV":             {LV, "1050", "LV-1050", nil},
+		"LVPrefixed":     {LV, "lv-1050", "LV-1050", nil},
+		"IE":             {IE, "d02x285", "D02 X285", nil},
+		"NoPostalCodes":  {AE, "", "", nil},
+		"NoPostalCodesX": {AE, "12345", "", ErrInvalidPostalCode},
+		"UnknownFormat":  {BT, "11001", "11001", nil},
+		"UnknownEmpty":   {BT, "", "", ErrInvalidPostalCode},
+		"Empty":          {GB, "", "", ErrInvalidPostalCode},
+		"UnknownCountry": {UnknownCountry, "12345", "", ErrInvalidPostalCode},
+		"InvalidCountry": {250, "", "", ErrInvalidPostalCode},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := ValidatePostalCode(tc.country, tc.code)
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Errorf("ValidatePostalCode() error = %v, wantErr %v", err, tc.wantErr)
+				}
+			} else {
+				if err != nil || got != tc.want {
+					t.Errorf("ValidatePostalCode() got = %v, %v, want %v", got, err, tc.want)
+				}
+			}
+		})
+	}
+}
+
+func TestValidatePostalCode_Error(t *testing.T) {
+	_, err := ValidatePostalCode(DE, "1011")
+
+	var got *ParseError
+	if !errors.As(err, &got) || got.Format != FormatNational || got.Country != DE {
+		t.Fatalf("ValidatePostalCode() error = %#v, want *ParseError with national format of DE", err)
+	}
+
+	want := `invalid postal code: "1011" is not a valid postal code in national format of DE`
+	if got.Error() != want {
+		t.Errorf("Error() got = %v, want %v", got.Error(), want)
+	}
+}
+
+func TestCountryCode_PostalCodeExample(t *testing.T) {
+	for c := range countryPostalCodes {
+		example := c.PostalCodeExample()
+
+		if got, err := ValidatePostalCode(c, example); err != nil || got != example {
+			t.Errorf("ValidatePostalCode(%v, %v) got = %v, %v", c, example, got, err)
+		}
+	}
+
+	for c := range countriesWithoutPostalCodes {
+		if _, ok := countryPostalCodes[c]; ok || c.HasPostalCodes() {
+			t.Errorf("%v has postal codes format, but is listed without postal codes", c)
+		}
+	}
+}
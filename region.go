@@ -0,0 +1,557 @@
+package isocodes
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Region represents a geographic region of the UN M49 standard.
+// The value of the Region is its M49 numeric code.
+type Region uint16
+
+// Enumeration of UN M49 geographic regions.
+const (
+	// RegionWorld represents the M49 region World.
+	RegionWorld Region = 1
+	// RegionAfrica represents the M49 region Africa.
+	RegionAfrica Region = 2
+	// RegionNorthernAfrica represents the M49 sub-region Northern Africa.
+	RegionNorthernAfrica Region = 15
+	// RegionSubSaharanAfrica represents the M49 sub-region Sub-Saharan Africa.
+	RegionSubSaharanAfrica Region = 202
+	// RegionEasternAfrica represents the M49 intermediate region Eastern Africa.
+	RegionEasternAfrica Region = 14
+	// RegionMiddleAfrica represents the M49 intermediate region Middle Africa.
+	RegionMiddleAfrica Region = 17
+	// RegionSouthernAfrica represents the M49 intermediate region Southern Africa.
+	RegionSouthernAfrica Region = 18
+	// RegionWesternAfrica represents the M49 intermediate region Western Africa.
+	RegionWesternAfrica Region = 11
+	// RegionAmericas represents the M49 region Americas.
+	RegionAmericas Region = 19
+	// RegionLatinAmericaAndCaribbean represents the M49 sub-region Latin America and the Caribbean.
+	RegionLatinAmericaAndCaribbean Region = 419
+	// RegionCaribbean represents the M49 intermediate region Caribbean.
+	RegionCaribbean Region = 29
+	// RegionCentralAmerica represents the M49 intermediate region Central America.
+	RegionCentralAmerica Region = 13
+	// RegionSouthAmerica represents the M49 intermediate region South America.
+	RegionSouthAmerica Region = 5
+	// RegionNorthernAmerica represents the M49 sub-region Northern America.
+	RegionNorthernAmerica Region = 21
+	// RegionAsia represents the M49 region Asia.
+	RegionAsia Region = 142
+	// RegionCentralAsia represents the M49 sub-region Central Asia.
+	RegionCentralAsia Region = 143
+	// RegionEasternAsia represents the M49 sub-region Eastern Asia.
+	RegionEasternAsia Region = 30
+	// RegionSouthEasternAsia represents the M49 sub-region South-eastern Asia.
+	RegionSouthEasternAsia Region = 35
+	// RegionSouthernAsia represents the M49 sub-region Southern Asia.
+	RegionSouthernAsia Region = 34
+	// RegionWesternAsia represents the M49 sub-region Western Asia.
+	RegionWesternAsia Region = 145
+	// RegionEurope represents the M49 region Europe.
+	RegionEurope Region = 150
+	// RegionEasternEurope represents the M49 sub-region Eastern Europe.
+	RegionEasternEurope Region = 151
+	// RegionNorthernEurope represents the M49 sub-region Northern Europe.
+	RegionNorthernEurope Region = 154
+	// RegionChannelIslands represents the M49 intermediate region Channel Islands.
+	RegionChannelIslands Region = 830
+	// RegionSouthernEurope represents the M49 sub-region Southern Europe.
+	RegionSouthernEurope Region = 39
+	// RegionWesternEurope represents the M49 sub-region Western Europe.
+	RegionWesternEurope Region = 155
+	// RegionOceania represents the M49 region Oceania.
+	RegionOceania Region = 9
+	// RegionAustraliaAndNewZealand represents the M49 sub-region Australia and New Zealand.
+	RegionAustraliaAndNewZealand Region = 53
+	// RegionMelanesia represents the M49 sub-region Melanesia.
+	RegionMelanesia Region = 54
+	// RegionMicronesia represents the M49 sub-region Micronesia.
+	RegionMicronesia Region = 57
+	// RegionPolynesia represents the M49 sub-region Polynesia.
+	RegionPolynesia Region = 61
+)
+
+// String returns a name of the region.
+func (r Region) String() string { return regionsDetails[r].name }
+
+// Code returns a three digits M49 code of the region.
+func (r Region) Code() string { return fmt.Sprintf("%03d", r) }
+
+// IsValid reports whether the region is a known M49 region.
+func (r Region) IsValid() bool {
+	_, ok := regionsDetails[r]
+
+	return ok
+}
+
+// Parent returns the region which contains r.
+// For RegionWorld and invalid regions it returns zero value.
+func (r Region) Parent() Region { return regionsDetails[r].parent }
+
+// Children returns the regions directly contained by r in code order.
+func (r Region) Children() []Region {
+	children := make([]Region, 0)
+
+	for _, region := range ListRegions() {
+		if region.Parent() == r {
+			children = append(children, region)
+		}
+	}
+
+	return children
+}
+
+// ContainsRegion reports whether the region other is r or is contained by r.
+func (r Region) ContainsRegion(other Region) bool {
+	for ; other != 0; other = other.Parent() {
+		if other == r {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Contains reports whether the country belongs to the region.
+func (r Region) Contains(c CountryCode) bool {
+	entry, ok := countryCodesM49[c]
+	if !ok {
+		return r == RegionWorld && c.IsValid()
+	}
+
+	return r.ContainsRegion(entry.region)
+}
+
+// Countries returns countries which belong to the region sorted by string representation.
+func (r Region) Countries() []CountryCode {
+	countries := make([]CountryCode, 0)
+
+	for _, c := range ListCountryCodes() {
+		if r.Contains(c) {
+			countries = append(countries, c)
+		}
+	}
+
+	return countries
+}
+
+// depth returns the number of ancestors of the region.
+func (r Region) depth() int {
+	d := 0
+
+	for p := r.Parent(); p != 0; p = p.Parent() {
+		d++
+	}
+
+	return d
+}
+
+// ListRegions returns a list of all M49 regions in code order.
+func ListRegions() []Region {
+	regions := make([]Region, 0, len(regionsDetails))
+
+	for r := range regionsDetails {
+		regions = append(regions, r)
+	}
+
+	sort.Slice(regions, func(i, j int) bool { return regions[i] < regions[j] })
+
+	return regions
+}
+
+// Region returns the M49 region of the country, e.g. RegionEurope.
+// For countries without a region, e.g. Antarctica, it returns zero value.
+func (c CountryCode) Region() Region { return c.regionAtDepth(1) }
+
+// SubRegion returns the M49 sub-region of the country, e.g. RegionSubSaharanAfrica.
+// For countries without a sub-region it returns zero value.
+func (c CountryCode) SubRegion() Region { return c.regionAtDepth(2) }
+
+// IntermediateRegion returns the M49 intermediate region of the country,
+// e.g. RegionEasternAfrica. For countries without an intermediate region,
+// e.g. Germany, it returns zero value.
+func (c CountryCode) IntermediateRegion() Region { return c.regionAtDepth(3) }
+
+// IsLDC reports whether the country is classified by M49
+// as one of the Least Developed Countries.
+func (c CountryCode) IsLDC() bool { return countryCodesM49[c].flags&m49LDC != 0 }
+
+// IsLLDC reports whether the country is classified by M49
+// as one of the Land Locked Developing Countries.
+func (c CountryCode) IsLLDC() bool { return countryCodesM49[c].flags&m49LLDC != 0 }
+
+// IsSIDS reports whether the country is classified by M49
+// as one of the Small Island Developing States.
+func (c CountryCode) IsSIDS() bool { return countryCodesM49[c].flags&m49SIDS != 0 }
+
+// regionAtDepth returns the region of the country with given depth.
+func (c CountryCode) regionAtDepth(depth int) Region {
+	r := countryCodesM49[c].region
+
+	for d := r.depth(); d > depth; d-- {
+		r = r.Parent()
+	}
+
+	if r.depth() != depth {
+		return 0
+	}
+
+	return r
+}
+
+// regionDetails represents detailed information related to the region.
+type regionDetails struct {
+	name   string
+	parent Region
+}
+
+var regionsDetails = map[Region]regionDetails{
+	RegionWorld:                    {name: "World"},
+	RegionAfrica:                   {name: "Africa", parent: RegionWorld},
+	RegionNorthernAfrica:           {name: "Northern Africa", parent: RegionAfrica},
+	RegionSubSaharanAfrica:         {name: "Sub-Saharan Africa", parent: RegionAfrica},
+	RegionEasternAfrica:            {name: "Eastern Africa", parent: RegionSubSaharanAfrica},
+	RegionMiddleAfrica:             {name: "Middle Africa", parent: RegionSubSaharanAfrica},
+	RegionSouthernAfrica:           {name: "Southern Africa", parent: RegionSubSaharanAfrica},
+	RegionWesternAfrica:            {name: "Western Africa", parent: RegionSubSaharanAfrica},
+	RegionAmericas:                 {name: "Americas", parent: RegionWorld},
+	RegionLatinAmericaAndCaribbean: {name: "Latin America and the Caribbean", parent: RegionAmericas},
+	RegionCaribbean:                {name: "Caribbean", parent: RegionLatinAmericaAndCaribbean},
+	RegionCentralAmerica:           {name: "Central America", parent: RegionLatinAmericaAndCaribbean},
+	RegionSouthAmerica:             {name: "South America", parent: RegionLatinAmericaAndCaribbean},
+	RegionNorthernAmerica:          {name: "Northern America", parent: RegionAmericas},
+	RegionAsia:                     {name: "Asia", parent: RegionWorld},
+	RegionCentralAsia:              {name: "Central Asia", parent: RegionAsia},
+	RegionEasternAsia:              {name: "Eastern Asia", parent: RegionAsia},
+	RegionSouthEasternAsia:         {name: "South-eastern Asia", parent: RegionAsia},
+	RegionSouthernAsia:             {name: "Southern Asia", parent: RegionAsia},
+	RegionWesternAsia:              {name: "Western Asia", parent: RegionAsia},
+	RegionEurope:                   {name: "Europe", parent: RegionWorld},
+	RegionEasternEurope:            {name: "Eastern Europe", parent: RegionEurope},
+	RegionNorthernEurope:           {name: "Northern Europe", parent: RegionEurope},
+	RegionChannelIslands:           {name: "Channel Islands", parent: RegionNorthernEurope},
+	RegionSouthernEurope:           {name: "Southern Europe", parent: RegionEurope},
+	RegionWesternEurope:            {name: "Western Europe", parent: RegionEurope},
+	RegionOceania:                  {name: "Oceania", parent: RegionWorld},
+	RegionAustraliaAndNewZealand:   {name: "Australia and New Zealand", parent: RegionOceania},
+	RegionMelanesia:                {name: "Melanesia", parent: RegionOceania},
+	RegionMicronesia:               {name: "Micronesia", parent: RegionOceania},
+	RegionPolynesia:                {name: "Polynesia", parent: RegionOceania},
+}
+
+// m49Flags represents M49 classification flags of the country.
+type m49Flags uint8
+
+const (
+	m49LDC m49Flags = 1 << iota
+	m49LLDC
+	m49SIDS
+)
+
+// m49Entry represents M49 classification of the country.
+type m49Entry struct {
+	// region holds the most specific region of the country.
+	region Region
+	flags  m49Flags
+}
+
+// countryCodesM49 holds M49 classification of countries.
+// LDC, LLDC and SIDS flags correspond to the M49 table as of 2023.
+// Antarctica has no region in M49, so it's not present.
+var countryCodesM49 = map[CountryCode]m49Entry{
+	// Northern Africa.
+	DZ: {region: RegionNorthernAfrica},
+	EG: {region: RegionNorthernAfrica},
+	LY: {region: RegionNorthernAfrica},
+	MA: {region: RegionNorthernAfrica},
+	SD: {region: RegionNorthernAfrica, flags: m49LDC},
+	TN: {region: RegionNorthernAfrica},
+	EH: {region: RegionNorthernAfrica},
+
+	// Eastern Africa.
+	IO: {region: RegionEasternAfrica},
+	BI: {region: RegionEasternAfrica, flags: m49LDC | m49LLDC},
+	KM: {region: RegionEasternAfrica, flags: m49LDC | m49SIDS},
+	DJ: {region: RegionEasternAfrica, flags: m49LDC},
+	ER: {region: RegionEasternAfrica, flags: m49LDC},
+	ET: {region: RegionEasternAfrica, flags: m49LDC | m49LLDC},
+	TF: {region: RegionEasternAfrica},
+	KE: {region: RegionEasternAfrica},
+	MG: {region: RegionEasternAfrica, flags: m49LDC},
+	MW: {region: RegionEasternAfrica, flags: m49LDC | m49LLDC},
+	MU: {region: RegionEasternAfrica, flags: m49SIDS},
+	YT: {region: RegionEasternAfrica},
+	MZ: {region: RegionEasternAfrica, flags: m49LDC},
+	RE: {region: RegionEasternAfrica},
+	RW: {region: RegionEasternAfrica, flags: m49LDC | m49LLDC},
+	SC: {region: RegionEasternAfrica, flags: m49SIDS},
+	SO: {region: RegionEasternAfrica, flags: m49LDC},
+	SS: {region: RegionEasternAfrica, flags: m49LDC | m49LLDC},
+	UG: {region: RegionEasternAfrica, flags: m49LDC | m49LLDC},
+	TZ: {region: RegionEasternAfrica, flags: m49LDC},
+	ZM: {region: RegionEasternAfrica, flags: m49LDC | m49LLDC},
+	ZW: {region: RegionEasternAfrica, flags: m49LLDC},
+
+	// Middle Africa.
+	AO: {region: RegionMiddleAfrica, flags: m49LDC},
+	CM: {region: RegionMiddleAfrica},
+	CF: {region: RegionMiddleAfrica, flags: m49LDC | m49LLDC},
+	TD: {region: RegionMiddleAfrica, flags: m49LDC | m49LLDC},
+	CG: {region: RegionMiddleAfrica},
+	CD: {region: RegionMiddleAfrica, flags: m49LDC},
+	GQ: {region: RegionMiddleAfrica},
+	GA: {region: RegionMiddleAfrica},
+	ST: {region: RegionMiddleAfrica, flags: m49LDC | m49SIDS},
+
+	// Southern Africa.
+	BW: {region: RegionSouthernAfrica, flags: m49LLDC},
+	SZ: {region: RegionSouthernAfrica, flags: m49LLDC},
+	LS: {region: RegionSouthernAfrica, flags: m49LDC | m49LLDC},
+	NA: {region: RegionSouthernAfrica},
+	ZA: {region: RegionSouthernAfrica},
+
+	// Western Africa.
+	BJ: {region: RegionWesternAfrica, flags: m49LDC},
+	BF: {region: RegionWesternAfrica, flags: m49LDC | m49LLDC},
+	CV: {region: RegionWesternAfrica, flags: m49SIDS},
+	CI: {region: RegionWesternAfrica},
+	GM: {region: RegionWesternAfrica, flags: m49LDC},
+	GH: {region: RegionWesternAfrica},
+	GN: {region: RegionWesternAfrica, flags: m49LDC},
+	GW: {region: RegionWesternAfrica, flags: m49LDC | m49SIDS},
+	LR: {region: RegionWesternAfrica, flags: m49LDC},
+	ML: {region: RegionWesternAfrica, flags: m49LDC | m49LLDC},
+	MR: {region: RegionWesternAfrica, flags: m49LDC},
+	NE: {region: RegionWesternAfrica, flags: m49LDC | m49LLDC},
+	NG: {region: RegionWesternAfrica},
+	SH: {region: RegionWesternAfrica},
+	SN: {region: RegionWesternAfrica, flags: m49LDC},
+	SL: {region: RegionWesternAfrica, flags: m49LDC},
+	TG: {region: RegionWesternAfrica, flags: m49LDC},
+
+	// Caribbean.
+	AI: {region: RegionCaribbean, flags: m49SIDS},
+	AG: {region: RegionCaribbean, flags: m49SIDS},
+	AW: {region: RegionCaribbean, flags: m49SIDS},
+	BS: {region: RegionCaribbean, flags: m49SIDS},
+	BB: {region: RegionCaribbean, flags: m49SIDS},
+	BQ: {region: RegionCaribbean},
+	VG: {region: RegionCaribbean, flags: m49SIDS},
+	KY: {region: RegionCaribbean, flags: m49SIDS},
+	CU: {region: RegionCaribbean, flags: m49SIDS},
+	CW: {region: RegionCaribbean, flags: m49SIDS},
+	DM: {region: RegionCaribbean, flags: m49SIDS},
+	DO: {region: RegionCaribbean, flags: m49SIDS},
+	GD: {region: RegionCaribbean, flags: m49SIDS},
+	GP: {region: RegionCaribbean},
+	HT: {region: RegionCaribbean, flags: m49LDC | m49SIDS},
+	JM: {region: RegionCaribbean, flags: m49SIDS},
+	MQ: {region: RegionCaribbean},
+	MS: {region: RegionCaribbean, flags: m49SIDS},
+	PR: {region: RegionCaribbean, flags: m49SIDS},
+	BL: {region: RegionCaribbean},
+	KN: {region: RegionCaribbean, flags: m49SIDS},
+	LC: {region: RegionCaribbean, flags: m49SIDS},
+	MF: {region: RegionCaribbean},
+	VC: {region: RegionCaribbean, flags: m49SIDS},
+	SX: {region: RegionCaribbean, flags: m49SIDS},
+	TT: {region: RegionCaribbean, flags: m49SIDS},
+	TC: {region: RegionCaribbean, flags: m49SIDS},
+	VI: {region: RegionCaribbean, flags: m49SIDS},
+
+	// Central America.
+	BZ: {region: RegionCentralAmerica, flags: m49SIDS},
+	CR: {region: RegionCentralAmerica},
+	SV: {region: RegionCentralAmerica},
+	GT: {region: RegionCentralAmerica},
+	HN: {region: RegionCentralAmerica},
+	MX: {region: RegionCentralAmerica},
+	NI: {region: RegionCentralAmerica},
+	PA: {region: RegionCentralAmerica},
+
+	// South America.
+	AR: {region: RegionSouthAmerica},
+	BO: {region: RegionSouthAmerica, flags: m49LLDC},
+	BV: {region: RegionSouthAmerica},
+	BR: {region: RegionSouthAmerica},
+	CL: {region: RegionSouthAmerica},
+	CO: {region: RegionSouthAmerica},
+	EC: {region: RegionSouthAmerica},
+	FK: {region: RegionSouthAmerica},
+	GF: {region: RegionSouthAmerica},
+	GY: {region: RegionSouthAmerica, flags: m49SIDS},
+	PY: {region: RegionSouthAmerica, flags: m49LLDC},
+	PE: {region: RegionSouthAmerica},
+	GS: {region: RegionSouthAmerica},
+	SR: {region: RegionSouthAmerica, flags: m49SIDS},
+	UY: {region: RegionSouthAmerica},
+	VE: {region: RegionSouthAmerica},
+
+	// Northern America.
+	BM: {region: RegionNorthernAmerica, flags: m49SIDS},
+	CA: {region: RegionNorthernAmerica},
+	GL: {region: RegionNorthernAmerica},
+	PM: {region: RegionNorthernAmerica},
+	US: {region: RegionNorthernAmerica},
+
+	// Central Asia.
+	KZ: {region: RegionCentralAsia, flags: m49LLDC},
+	KG: {region: RegionCentralAsia, flags: m49LLDC},
+	TJ: {region: RegionCentralAsia, flags: m49LLDC},
+	TM: {region: RegionCentralAsia, flags: m49LLDC},
+	UZ: {region: RegionCentralAsia, flags: m49LLDC},
+
+	// Eastern Asia.
+	CN: {region: RegionEasternAsia},
+	HK: {region: RegionEasternAsia},
+	MO: {region: RegionEasternAsia},
+	KP: {region: RegionEasternAsia},
+	JP: {region: RegionEasternAsia},
+	MN: {region: RegionEasternAsia, flags: m49LLDC},
+	KR: {region: RegionEasternAsia},
+	TW: {region: RegionEasternAsia},
+
+	// South-eastern Asia.
+	BN: {region: RegionSouthEasternAsia},
+	KH: {region: RegionSouthEasternAsia, flags: m49LDC},
+	ID: {region: RegionSouthEasternAsia},
+	LA: {region: RegionSouthEasternAsia, flags: m49LDC | m49LLDC},
+	MY: {region: RegionSouthEasternAsia},
+	MM: {region: RegionSouthEasternAsia, flags: m49LDC},
+	PH: {region: RegionSouthEasternAsia},
+	SG: {region: RegionSouthEasternAsia, flags: m49SIDS},
+	TH: {region: RegionSouthEasternAsia},
+	TL: {region: RegionSouthEasternAsia, flags: m49LDC | m49SIDS},
+	VN: {region: RegionSouthEasternAsia},
+
+	// Southern Asia.
+	AF: {region: RegionSouthernAsia, flags: m49LDC | m49LLDC},
+	BD: {region: RegionSouthernAsia, flags: m49LDC},
+	BT: {region: RegionSouthernAsia, flags: m49LLDC},
+	IN: {region: RegionSouthernAsia},
+	IR: {region: RegionSouthernAsia},
+	MV: {region: RegionSouthernAsia, flags: m49SIDS},
+	NP: {region: RegionSouthernAsia, flags: m49LDC | m49LLDC},
+	PK: {region: RegionSouthernAsia},
+	LK: {region: RegionSouthernAsia},
+
+	// Western Asia.
+	AM: {region: RegionWesternAsia, flags: m49LLDC},
+	AZ: {region: RegionWesternAsia, flags: m49LLDC},
+	BH: {region: RegionWesternAsia, flags: m49SIDS},
+	CY: {region: RegionWesternAsia},
+	GE: {region: RegionWesternAsia},
+	IQ: {region: RegionWesternAsia},
+	IL: {region: RegionWesternAsia},
+	JO: {region: RegionWesternAsia},
+	KW: {region: RegionWesternAsia},
+	LB: {region: RegionWesternAsia},
+	OM: {region: RegionWesternAsia},
+	QA: {region: RegionWesternAsia},
+	SA: {region: RegionWesternAsia},
+	PS: {region: RegionWesternAsia},
+	SY: {region: RegionWesternAsia},
+	TR: {region: RegionWesternAsia},
+	AE: {region: RegionWesternAsia},
+	YE: {region: RegionWesternAsia, flags: m49LDC},
+
+	// Eastern Europe.
+	BY: {region: RegionEasternEurope},
+	BG: {region: RegionEasternEurope},
+	CZ: {region: RegionEasternEurope},
+	HU: {region: RegionEasternEurope},
+	PL: {region: RegionEasternEurope},
+	MD: {region: RegionEasternEurope, flags: m49LLDC},
+	RO: {region: RegionEasternEurope},
+	RU: {region: RegionEasternEurope},
+	SK: {region: RegionEasternEurope},
+	UA: {region: RegionEasternEurope},
+
+	// Northern Europe.
+	AX: {region: RegionNorthernEurope},
+	DK: {region: RegionNorthernEurope},
+	EE: {region: RegionNorthernEurope},
+	FO: {region: RegionNorthernEurope},
+	FI: {region: RegionNorthernEurope},
+	IS: {region: RegionNorthernEurope},
+	IE: {region: RegionNorthernEurope},
+	IM: {region: RegionNorthernEurope},
+	LV: {region: RegionNorthernEurope},
+	LT: {region: RegionNorthernEurope},
+	NO: {region: RegionNorthernEurope},
+	SJ: {region: RegionNorthernEurope},
+	SE: {region: RegionNorthernEurope},
+	GB: {region: RegionNorthernEurope},
+	GG: {region: RegionChannelIslands},
+	JE: {region: RegionChannelIslands},
+
+	// Southern Europe.
+	AL: {region: RegionSouthernEurope},
+	AD: {region: RegionSouthernEurope},
+	BA: {region: RegionSouthernEurope},
+	HR: {region: RegionSouthernEurope},
+	GI: {region: RegionSouthernEurope},
+	GR: {region: RegionSouthernEurope},
+	VA: {region: RegionSouthernEurope},
+	IT: {region: RegionSouthernEurope},
+	MT: {region: RegionSouthernEurope},
+	ME: {region: RegionSouthernEurope},
+	MK: {region: RegionSouthernEurope, flags: m49LLDC},
+	PT: {region: RegionSouthernEurope},
+	SM: {region: RegionSouthernEurope},
+	RS: {region: RegionSouthernEurope},
+	SI: {region: RegionSouthernEurope},
+	ES: {region: RegionSouthernEurope},
+
+	// Western Europe.
+	AT: {region: RegionWesternEurope},
+	BE: {region: RegionWesternEurope},
+	FR: {region: RegionWesternEurope},
+	DE: {region: RegionWesternEurope},
+	LI: {region: RegionWesternEurope},
+	LU: {region: RegionWesternEurope},
+	MC: {region: RegionWesternEurope},
+	NL: {region: RegionWesternEurope},
+	CH: {region: RegionWesternEurope},
+
+	// Australia and New Zealand.
+	AU: {region: RegionAustraliaAndNewZealand},
+	CX: {region: RegionAustraliaAndNewZealand},
+	CC: {region: RegionAustraliaAndNewZealand},
+	HM: {region: RegionAustraliaAndNewZealand},
+	NZ: {region: RegionAustraliaAndNewZealand},
+	NF: {region: RegionAustraliaAndNewZealand},
+
+	// Melanesia.
+	FJ: {region: RegionMelanesia, flags: m49SIDS},
+	NC: {region: RegionMelanesia, flags: m49SIDS},
+	PG: {region: RegionMelanesia, flags: m49SIDS},
+	SB: {region: RegionMelanesia, flags: m49LDC | m49SIDS},
+	VU: {region: RegionMelanesia, flags: m49SIDS},
+
+	// Micronesia.
+	GU: {region: RegionMicronesia, flags: m49SIDS},
+	KI: {region: RegionMicronesia, flags: m49LDC | m49SIDS},
+	MH: {region: RegionMicronesia, flags: m49SIDS},
+	FM: {region: RegionMicronesia, flags: m49SIDS},
+	NR: {region: RegionMicronesia, flags: m49SIDS},
+	MP: {region: RegionMicronesia, flags: m49SIDS},
+	PW: {region: RegionMicronesia, flags: m49SIDS},
+	UM: {region: RegionMicronesia},
+
+	// Polynesia.
+	AS: {region: RegionPolynesia, flags: m49SIDS},
+	CK: {region: RegionPolynesia, flags: m49SIDS},
+	PF: {region: RegionPolynesia, flags: m49SIDS},
+	NU: {region: RegionPolynesia, flags: m49SIDS},
+	PN: {region: RegionPolynesia},
+	WS: {region: RegionPolynesia, flags: m49SIDS},
+	TK: {region: RegionPolynesia},
+	TO: {region: RegionPolynesia, flags: m49SIDS},
+	TV: {region: RegionPolynesia, flags: m49LDC | m49SIDS},
+	WF: {region: RegionPolynesia},
+}
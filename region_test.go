@@ -0,0 +1,153 @@
+package isocodes
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCountryCode_Region(t *testing.T) {
+	type tcase struct {
+		code         CountryCode
+		region       Region
+		subRegion    Region
+		intermediate Region
+	}
+
+	tests := map[string]tcase{
+		"KE":   {KE, RegionAfrica, RegionSubSaharanAfrica, RegionEasternAfrica},
+		"EG":   {EG, RegionAfrica, RegionNorthernAfrica, 0},
+		"DE":   {DE, RegionEurope, RegionWesternEurope, 0},
+		"JE":   {JE, RegionEurope, RegionNorthernEurope, RegionChannelIslands},
+		"BR":   {BR, RegionAmericas, RegionLatinAmericaAndCaribbean, RegionSouthAmerica},
+		"US":   {US, RegionAmericas, RegionNorthernAmerica, 0},
+		"JP":   {JP, RegionAsia, RegionEasternAsia, 0},
+		"FJ":   {FJ, RegionOceania, RegionMelanesia, 0},
+		"AQ":   {AQ, 0, 0, 0},
+		"Zero": {UnknownCountry, 0, 0, 0},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := tc.code.Region(); got != tc.region {
+				t.Errorf("Region() got = %v, want %v", got, tc.region)
+			}
+
+			if got := tc.code.SubRegion(); got != tc.subRegion {
+				t.Errorf("SubRegion() got = %v, want %v", got, tc.subRegion)
+			}
+
+			if got := tc.code.IntermediateRegion(); got != tc.intermediate {
+				t.Errorf("IntermediateRegion() got = %v, want %v", got, tc.intermediate)
+			}
+		})
+	}
+}
+
+func TestCountryCode_M49Flags(t *testing.T) {
+	type tcase struct {
+		code            CountryCode
+		ldc, lldc, sids bool
+	}
+
+	tests := map[string]tcase{
+		"AF": {AF, true, true, false},
+		"HT": {HT, true, false, true},
+		"MN": {MN, false, true, false},
+		"SG": {SG, false, false, true},
+		"DE": {DE, false, false, false},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := tc.code.IsLDC(); got != tc.ldc {
+				t.Errorf("IsLDC() got = %v, want %v", got, tc.ldc)
+			}
+
+			if got := tc.code.IsLLDC(); got != tc.lldc {
+				t.Errorf("IsLLDC() got = %v, want %v", got, tc.lldc)
+			}
+
+			if got := tc.code.IsSIDS(); got != tc.sids {
+				t.Errorf("IsSIDS() got = %v, want %v", got, tc.sids)
+			}
+		})
+	}
+}
+
+func TestRegion_Countries(t *testing.T) {
+	type tcase struct {
+		region Region
+		want   []CountryCode
+	}
+
+	tests := map[string]tcase{
+		"ChannelIslands": {RegionChannelIslands, []CountryCode{GG, JE}},
+		"CentralAsia":    {RegionCentralAsia, []CountryCode{KG, KZ, TJ, TM, UZ}},
+		"WesternEurope":  {RegionWesternEurope, []CountryCode{AT, BE, CH, DE, FR, LI, LU, MC, NL}},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := tc.region.Countries(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("Countries() got = %v, want %v", got, tc.want)
+			}
+		})
+	}
+
+	t.Run("World", func(t *testing.T) {
+		if got := len(RegionWorld.Countries()); got != len(countryCodesDetails) {
+			t.Errorf("Countries() got len = %d, want %d", got, len(countryCodesDetails))
+		}
+	})
+
+	t.Run("Coverage", func(t *testing.T) {
+		for _, c := range ListCountryCodes() {
+			if _, ok := countryCodesM49[c]; !ok && c != AQ {
+				t.Errorf("country %v has no M49 region", c)
+			}
+		}
+	})
+
+	t.Run("Partition", func(t *testing.T) {
+		total := 0
+
+		for _, r := range RegionWorld.Children() {
+			total += len(r.Countries())
+		}
+
+		if total != len(countryCodesDetails)-1 {
+			t.Errorf("regions should partition countries, got %d", total)
+		}
+	})
+}
+
+func TestRegion(t *testing.T) {
+	if got := RegionSouthAmerica.Code(); got != "005" {
+		t.Errorf("Code() got = %v, want 005", got)
+	}
+
+	if got := RegionSubSaharanAfrica.String(); got != "Sub-Saharan Africa" {
+		t.Errorf("String() got = %v", got)
+	}
+
+	if !RegionAmericas.ContainsRegion(RegionCaribbean) || RegionEurope.ContainsRegion(RegionAsia) {
+		t.Errorf("ContainsRegion() got unexpected result")
+	}
+
+	if !RegionEurope.Contains(GB) || RegionEurope.Contains(US) || !RegionWorld.Contains(AQ) {
+		t.Errorf("Contains() got unexpected result")
+	}
+
+	if Region(999).IsValid() || !RegionWorld.IsValid() {
+		t.Errorf("IsValid() got unexpected result")
+	}
+
+	want := []Region{RegionAfrica, RegionOceania, RegionAmericas, RegionAsia, RegionEurope}
+	if got := RegionWorld.Children(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Children() got = %v, want %v", got, want)
+	}
+
+	if got := len(ListRegions()); got != len(regionsDetails) {
+		t.Errorf("ListRegions() got len = %d, want %d", got, len(regionsDetails))
+	}
+}
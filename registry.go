@@ -0,0 +1,197 @@
+package isocodes
+
+import (
+	"strings"
+	"sync"
+)
+
+// RegisterCountryCode registers a custom CountryCode described by details.
+// The registered code is recognized by StringToCountryCode, ListCountryCodes,
+// marshalling and the SQL layer just like the ISO 3166-1 codes.
+//
+// Only codes from the ISO 3166-1 user-assigned ranges are accepted:
+// Alpha2 AA, QM-QZ, XA-XZ and ZZ, Alpha3 AAA-AAZ, QMA-QZZ, XAA-XZZ and ZZA-ZZZ,
+// numeric 900-999. Alpha2 and Name are required, Alpha3 and Number are optional.
+// When Flag is empty, it is composed of regional indicator symbols of Alpha2.
+//
+// Registering the same details twice returns the same code, while registering
+// different details for the already known Alpha2 code causes ErrCodeExists.
+// Since CountryCode is a byte, only a few custom codes can be registered,
+// after that ErrRegistryFull is returned. Registration is safe for concurrent
+// use, but it is intended to be done once at the application startup.
+func RegisterCountryCode(details CountryCodeDetails) (CountryCode, error) {
+	details.Alpha2 = strings.ToUpper(details.Alpha2)
+	details.Alpha3 = strings.ToUpper(details.Alpha3)
+
+	if details.Name == "" {
+		return 0, ErrInvalidDetails
+	}
+
+	if !IsUserAssignedCountryCode(details.Alpha2) {
+		return 0, ErrNotUserAssigned
+	}
+
+	if details.Alpha3 != "" && !(len(details.Alpha3) == 3 && IsUserAssignedCountryCode(details.Alpha3[:2])) {
+		return 0, ErrNotUserAssigned
+	}
+
+	if details.Number != "" && !(len(details.Number) == 3 && details.Number >= "900" && details.Number <= "999") {
+		return 0, ErrNotUserAssigned
+	}
+
+	if details.Flag == "" {
+		details.Flag = regionalIndicators(details.Alpha2)
+	}
+
+	if _, ok := stringToCountryCode[details.Alpha2]; ok {
+		return 0, ErrCodeExists
+	}
+
+	return countryRegistry.register(details.Alpha2, details)
+}
+
+// RegisterCurrencyCode registers a custom CurrencyCode described by details.
+// The registered code is recognized by StringToCurrencyCode, ListCurrencyCodes,
+// marshalling and the SQL layer just like the ISO 4217 codes.
+//
+// ISO 4217 codes begin with ISO 3166-1 Alpha2 code, so only codes which begin
+// with a user-assigned country code are accepted, e.g. XLP or XUS, except
+// the ones already defined by ISO 4217 like XAU. Code and Name are required.
+// When Flag is empty, it is composed of regional indicator symbols
+// of the first two letters of Code.
+//
+// Registration rules and limitations are the same as for RegisterCountryCode.
+func RegisterCurrencyCode(details CurrencyCodeDetails) (CurrencyCode, error) {
+	details.Code = strings.ToUpper(details.Code)
+
+	if details.Name == "" || details.Decimals < 0 {
+		return 0, ErrInvalidDetails
+	}
+
+	if len(details.Code) != 3 || !isUpperLetters(details.Code) || !IsUserAssignedCountryCode(details.Code[:2]) {
+		return 0, ErrNotUserAssigned
+	}
+
+	if details.Flag == "" {
+		details.Flag = regionalIndicators(details.Code[:2])
+	}
+
+	if _, ok := stringToCurrencyCode[details.Code]; ok {
+		return 0, ErrCodeExists
+	}
+
+	return currencyRegistry.register(details.Code, details)
+}
+
+// IsUserAssignedCountryCode reports whether the Alpha2 code belongs to
+// the ISO 3166-1 user-assigned ranges: AA, QM-QZ, XA-XZ and ZZ.
+func IsUserAssignedCountryCode(alpha2 string) bool {
+	alpha2 = strings.ToUpper(alpha2)
+
+	if len(alpha2) != 2 || !isUpperLetters(alpha2) {
+		return false
+	}
+
+	switch alpha2[0] {
+	case 'A':
+		return alpha2[1] == 'A'
+	case 'Q':
+		return alpha2[1] >= 'M'
+	case 'X':
+		return true
+	case 'Z':
+		return alpha2[1] == 'Z'
+	default:
+		return false
+	}
+}
+
+// registry holds custom codes registered in the runtime.
+// Its zero value is not usable, use newRegistry instead.
+type registry[T Code, D comparable] struct {
+	mu      sync.RWMutex
+	next    int
+	details map[T]D
+	codes   map[string]T
+}
+
+// newRegistry returns a registry which assigns codes starting with first.
+func newRegistry[T Code, D comparable](first int) *registry[T, D] {
+	return &registry[T, D]{
+		next:    first,
+		details: make(map[T]D),
+		codes:   make(map[string]T),
+	}
+}
+
+// get returns details of the registered code.
+func (r *registry[T, D]) get(c T) (D, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	d, ok := r.details[c]
+
+	return d, ok
+}
+
+// lookup returns the registered code for its string representation.
+func (r *registry[T, D]) lookup(s string) (T, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	c, ok := r.codes[s]
+
+	return c, ok
+}
+
+// register assigns the next free code to details.
+func (r *registry[T, D]) register(s string, d D) (T, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if c, ok := r.codes[s]; ok {
+		if r.details[c] != d {
+			return 0, ErrCodeExists
+		}
+
+		return c, nil
+	}
+
+	if r.next >= maxCodes {
+		return 0, ErrRegistryFull
+	}
+
+	c := T(r.next)
+	r.next++
+	r.details[c] = d
+	r.codes[s] = c
+
+	return c, nil
+}
+
+// regionalIndicators returns an emoji flag composed of
+// regional indicator symbols for the two letters code.
+func regionalIndicators(alpha2 string) string {
+	const regionalIndicatorA = 0x1F1E6
+
+	return string([]rune{
+		rune(alpha2[0]-'A') + regionalIndicatorA,
+		rune(alpha2[1]-'A') + regionalIndicatorA,
+	})
+}
+
+// isUpperLetters reports whether s consists of ASCII upper case letters only.
+func isUpperLetters(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < 'A' || s[i] > 'Z' {
+			return false
+		}
+	}
+
+	return true
+}
+
+var (
+	countryRegistry  = newRegistry[CountryCode, CountryCodeDetails](int(ZW) + 1)
+	currencyRegistry = newRegistry[CurrencyCode, CurrencyCodeDetails](int(ZMW) + 1)
+)
@@ -0,0 +1,190 @@
+package isocodes
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+
+// resetRegistries restores registries to their initial state after the test.
+func resetRegistries(t *testing.T) {
+	t.Helper()
+
+	t.Cleanup(func() {
+		countryRegistry = newRegistry[CountryCode, CountryCodeDetails](int(ZW) + 1)
+		currencyRegistry = newRegistry[CurrencyCode, CurrencyCodeDetails](int(ZMW) + 1)
+	})
+}
+
+func TestRegisterCountryCode(t *testing.T) {
+	resetRegistries(t)
+
+	kosovo := CountryCodeDetails{Alpha2: "xk", Alpha3: "XKX", Name: "Kosovo"}
+
+	xk, err := RegisterCountryCode(kosovo)
+	if err != nil {
+		t.Fatalf("RegisterCountryCode() unexpected error = %v", err)
+	}
+
+	t.Run("Accessors", func(t *testing.T) {
+		if xk.String() != "XK" || xk.Alpha3() != "XKX" || xk.Name() != "Kosovo" || xk.Flag() != "🇽🇰" {
+			t.Errorf("RegisterCountryCode() got unexpected details %+v", xk.details())
+		}
+
+		if !xk.IsValid() {
+			t.Errorf("IsValid() got = false, want true")
+		}
+	})
+
+	t.Run("StringToCountryCode", func(t *testing.T) {
+		got, err := StringToCountryCode("xk")
+		if err != nil || got != xk {
+			t.Errorf("StringToCountryCode() got = %v, %v, want %v", got, err, xk)
+		}
+	})
+
+	t.Run("ListCountryCodes", func(t *testing.T) {
+		codes := ListCountryCodes()
+		if len(codes) != len(countryCodesDetails)+1 {
+			t.Errorf("ListCountryCodes() should have len == %d", len(countryCodesDetails)+1)
+		}
+	})
+
+	t.Run("Marshal", func(t *testing.T) {
+		b, err := xk.MarshalJSON()
+		if err != nil || string(b) != `"XK"` {
+			t.Errorf("MarshalJSON() got = %s, %v", b, err)
+		}
+
+		var got CountryCode
+		if err := got.Scan("XK"); err != nil || got != xk {
+			t.Errorf("Scan() got = %v, %v, want %v", got, err, xk)
+		}
+	})
+
+	t.Run("Idempotent", func(t *testing.T) {
+		got, err := RegisterCountryCode(kosovo)
+		if err != nil || got != xk {
+			t.Errorf("RegisterCountryCode() got = %v, %v, want %v", got, err, xk)
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		type tcase struct {
+			details CountryCodeDetails
+			wantErr error
+		}
+
+		tests := map[string]tcase{
+			"ISO":          {CountryCodeDetails{Alpha2: "DE", Name: "Germany"}, ErrNotUserAssigned},
+			"NoName":       {CountryCodeDetails{Alpha2: "XA"}, ErrInvalidDetails},
+			"Alpha3":       {CountryCodeDetails{Alpha2: "XA", Alpha3: "DEU", Name: "Test"}, ErrNotUserAssigned},
+			"Number":       {CountryCodeDetails{Alpha2: "XA", Number: "276", Name: "Test"}, ErrNotUserAssigned},
+			"QL":           {CountryCodeDetails{Alpha2: "QL", Name: "Test"}, ErrNotUserAssigned},
+			"Redefinition": {CountryCodeDetails{Alpha2: "XK", Name: "Other"}, ErrCodeExists},
+		}
+
+		for name, tc := range tests {
+			t.Run(name, func(t *testing.T) {
+				if _, err := RegisterCountryCode(tc.details); !errors.Is(err, tc.wantErr) {
+					t.Errorf("RegisterCountryCode() error = %v, wantErr %v", err, tc.wantErr)
+				}
+			})
+		}
+	})
+
+	t.Run("ErrRegistryFull", func(t *testing.T) {
+		var err error
+
+		for c := 'A'; c <= 'Z' && err == nil; c++ {
+			_, err = RegisterCountryCode(CountryCodeDetails{Alpha2: "X" + string(c), Name: "Test"})
+		}
+
+		if !errors.Is(err, ErrRegistryFull) {
+			t.Errorf("RegisterCountryCode() error = %v, wantErr %v", err, ErrRegistryFull)
+		}
+	})
+}
+
+func TestRegisterCurrencyCode(t *testing.T) {
+	resetRegistries(t)
+
+	points, err := RegisterCurrencyCode(CurrencyCodeDetails{Code: "XLP", Name: "Loyalty points"})
+	if err != nil {
+		t.Fatalf("RegisterCurrencyCode() unexpected error = %v", err)
+	}
+
+	if got, err := StringToCurrencyCode("xlp"); err != nil || got != points {
+		t.Errorf("StringToCurrencyCode() got = %v, %v, want %v", got, err, points)
+	}
+
+	if points.Name() != "Loyalty points" || points.Flag() != "🇽🇱" {
+		t.Errorf("RegisterCurrencyCode() got unexpected details %+v", points.details())
+	}
+
+	type tcase struct {
+		details CurrencyCodeDetails
+		wantErr error
+	}
+
+	tests := map[string]tcase{
+		"ISO":      {CurrencyCodeDetails{Code: "XAU", Name: "Gold"}, ErrCodeExists},
+		"Prefix":   {CurrencyCodeDetails{Code: "USC", Name: "Stablecoin"}, ErrNotUserAssigned},
+		"Length":   {CurrencyCodeDetails{Code: "XUSD", Name: "Stablecoin"}, ErrNotUserAssigned},
+		"Decimals": {CurrencyCodeDetails{Code: "XUS", Name: "Stablecoin", Decimals: -1}, ErrInvalidDetails},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := RegisterCurrencyCode(tc.details); !errors.Is(err, tc.wantErr) {
+				t.Errorf("RegisterCurrencyCode() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestRegistry_Concurrent(t *testing.T) {
+	resetRegistries(t)
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+
+		go func() {
+			defer wg.Done()
+
+			if _, err := RegisterCurrencyCode(CurrencyCodeDetails{Code: "XST", Name: "Stablecoin", Decimals: 6}); err != nil {
+				t.Errorf("RegisterCurrencyCode() unexpected error = %v", err)
+			}
+		}()
+
+		go func() {
+			defer wg.Done()
+
+			_ = ListCurrencyCodes()
+			_, _ = StringToCurrencyCode("XST")
+		}()
+	}
+
+	wg.Wait()
+
+	if got := len(ListCurrencyCodes()); got != len(currencyCodesDetails)+1 {
+		t.Errorf("ListCurrencyCodes() got len = %d, want %d", got, len(currencyCodesDetails)+1)
+	}
+}
+
+func TestIsUserAssignedCountryCode(t *testing.T) {
+	tests := map[string]bool{
+		"AA": true, "AB": false, "QL": false, "QM": true, "QZ": true,
+		"XA": true, "XK": true, "ZZ": true, "ZY": false, "xk": true, "X1": false, "XKX": false,
+	}
+
+	for code, want := range tests {
+		t.Run(code, func(t *testing.T) {
+			if got := IsUserAssignedCountryCode(code); got != want {
+				t.Errorf("IsUserAssignedCountryCode() got = %v, want %v", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,267 @@
+package isocodes
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math/bits"
+	"sort"
+	"strings"
+)
+
+// CountrySet represents a set of country codes.
+type CountrySet = Set[CountryCode]
+
+// CurrencySet represents a set of currency codes.
+type CurrencySet = Set[CurrencyCode]
+
+// Set represents a set of codes of type T.
+// Since all codes fit in a byte, the set is a 256-bit value,
+// so it is cheap to copy and can be used as a map key.
+// The zero value is an empty set ready to use.
+type Set[T Code] struct {
+	bits [maxCodes / 64]uint64
+}
+
+// NewSet returns a Set which contains given codes.
+func NewSet[T Code](codes ...T) Set[T] {
+	var s Set[T]
+
+	s.Add(codes...)
+
+	return s
+}
+
+// Add adds given codes to the set.
+func (s *Set[T]) Add(codes ...T) {
+	for _, c := range codes {
+		s.bits[c/64] |= 1 << (c % 64)
+	}
+}
+
+// Remove removes given codes from the set.
+func (s *Set[T]) Remove(codes ...T) {
+	for _, c := range codes {
+		s.bits[c/64] &^= 1 << (c % 64)
+	}
+}
+
+// Contains reports whether the set contains the code.
+func (s Set[T]) Contains(c T) bool { return s.bits[c/64]&(1<<(c%64)) != 0 }
+
+// Len returns the number of codes in the set.
+func (s Set[T]) Len() int {
+	n := 0
+
+	for _, w := range s.bits {
+		n += bits.OnesCount64(w)
+	}
+
+	return n
+}
+
+// Codes returns the codes of the set in code order.
+func (s Set[T]) Codes() []T {
+	codes := make([]T, 0, s.Len())
+
+	for i, w := range s.bits {
+		for w != 0 {
+			codes = append(codes, T(i*64+bits.TrailingZeros64(w)))
+			w &= w - 1
+		}
+	}
+
+	return codes
+}
+
+// IsEmpty reports whether the set has no codes.
+func (s Set[T]) IsEmpty() bool { return s == Set[T]{} }
+
+// Union returns a set of codes which are in s or in o.
+func (s Set[T]) Union(o Set[T]) Set[T] {
+	for i := range s.bits {
+		s.bits[i] |= o.bits[i]
+	}
+
+	return s
+}
+
+// Intersect returns a set of codes which are in both s and o.
+func (s Set[T]) Intersect(o Set[T]) Set[T] {
+	for i := range s.bits {
+		s.bits[i] &= o.bits[i]
+	}
+
+	return s
+}
+
+// Difference returns a set of codes which are in s, but not in o.
+func (s Set[T]) Difference(o Set[T]) Set[T] {
+	for i := range s.bits {
+		s.bits[i] &^= o.bits[i]
+	}
+
+	return s
+}
+
+// Each calls fn for each code of the set in code order
+// until fn returns false.
+func (s Set[T]) Each(fn func(c T) bool) {
+	for i, w := range s.bits {
+		for w != 0 {
+			if !fn(T(i*64 + bits.TrailingZeros64(w))) {
+				return
+			}
+
+			w &= w - 1
+		}
+	}
+}
+
+// String returns comma separated string representations
+// of the codes sorted alphabetically, e.g. "DE,FR".
+// Invalid codes are omitted.
+func (s Set[T]) String() string {
+	var codes []string
+
+	s.Each(func(c T) bool {
+		if c.IsValid() {
+			codes = append(codes, c.String())
+		}
+
+		return true
+	})
+
+	sort.Strings(codes)
+
+	return strings.Join(codes, ",")
+}
+
+// MarshalJSON implements json.Marshaler.
+// The set is marshalled as an array of codes sorted alphabetically.
+func (s Set[T]) MarshalJSON() ([]byte, error) {
+	codes, err := s.codeStrings(ErrMarshalJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	if codes == nil {
+		codes = []string{}
+	}
+
+	return json.Marshal(codes)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It accepts an array of codes, JSON null is unmarshalled into an empty set.
+func (s *Set[T]) UnmarshalJSON(b []byte) error {
+	var codes []string
+	if err := json.Unmarshal(b, &codes); err != nil {
+		return fmt.Errorf("%w: %s", ErrUnmarshalJSON, err)
+	}
+
+	return s.parse(codes, ErrUnmarshalJSON)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// The set is marshalled as comma separated codes sorted alphabetically.
+func (s Set[T]) MarshalText() ([]byte, error) {
+	codes, err := s.codeStrings(ErrMarshalJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(strings.Join(codes, ",")), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It accepts comma separated codes, an empty text is unmarshalled into an empty set.
+func (s *Set[T]) UnmarshalText(b []byte) error {
+	return s.parse(splitCodes(string(b)), ErrUnmarshalJSON)
+}
+
+// Scan implements sql.Scanner.
+// It accepts comma separated codes, SQL NULL is scanned into an empty set.
+func (s *Set[T]) Scan(src any) error {
+	str, err := sqlString(src)
+	if err != nil {
+		return err
+	}
+
+	return s.parse(splitCodes(str), ErrScanSQL)
+}
+
+// Value implements driver.Valuer.
+// The set is stored as comma separated codes sorted alphabetically,
+// an empty set is stored as SQL NULL.
+func (s Set[T]) Value() (driver.Value, error) {
+	codes, err := s.codeStrings(ErrValueSQL)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(codes) == 0 {
+		return nil, nil
+	}
+
+	return strings.Join(codes, ","), nil
+}
+
+// codeStrings returns string representations of the codes sorted alphabetically
+// according to the current MarshalPolicy.
+func (s Set[T]) codeStrings(errInvalid error) ([]string, error) {
+	var (
+		codes []string
+		err   error
+	)
+
+	s.Each(func(c T) bool {
+		var code string
+		if code, err = formatCode(c, errInvalid); err != nil {
+			return false
+		}
+
+		if code != "" {
+			codes = append(codes, code)
+		}
+
+		return true
+	})
+
+	sort.Strings(codes)
+
+	return codes, err
+}
+
+// parse replaces the set with the codes according to the current MarshalPolicy.
+func (s *Set[T]) parse(codes []string, errInvalid error) error {
+	var set Set[T]
+
+	for _, code := range codes {
+		var c T
+		if err := parseCode(&c, code, errInvalid); err != nil {
+			return err
+		}
+
+		if !c.IsZero() {
+			set.Add(c)
+		}
+	}
+
+	*s = set
+
+	return nil
+}
+
+// splitCodes splits comma separated codes ignoring spaces and empty elements.
+func splitCodes(s string) []string {
+	var codes []string
+
+	for _, code := range strings.Split(s, ",") {
+		if code = strings.TrimSpace(code); code != "" {
+			codes = append(codes, code)
+		}
+	}
+
+	return codes
+}
@@ -0,0 +1,159 @@
+package isocodes
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSet(t *testing.T) {
+	s := NewSet(DE, FR, ZW)
+	s.Add(AD, DE)
+	s.Remove(FR, GB)
+
+	if got := s.Len(); got != 3 {
+		t.Errorf("Len() got = %v, want %v", got, 3)
+	}
+
+	if !s.Contains(ZW) || s.Contains(FR) || s.Contains(UnknownCountry) {
+		t.Errorf("Contains() got unexpected result for %v", s.Codes())
+	}
+
+	if got, want := s.Codes(), []CountryCode{AD, DE, ZW}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Codes() got = %v, want %v", got, want)
+	}
+
+	if NewSet(DE, AD) != NewSet(AD, DE) {
+		t.Errorf("sets with the same codes should be equal")
+	}
+
+	var empty Set[CurrencyCode]
+	if empty.Len() != 0 || len(empty.Codes()) != 0 {
+		t.Errorf("zero Set should be empty")
+	}
+}
+
+func TestSet_Algebra(t *testing.T) {
+	a := NewSet(DE, FR, IT)
+	b := NewSet(FR, IT, ES)
+
+	if got, want := a.Union(b), NewSet(DE, ES, FR, IT); got != want {
+		t.Errorf("Union() got = %v, want %v", got, want)
+	}
+
+	if got, want := a.Intersect(b), NewSet(FR, IT); got != want {
+		t.Errorf("Intersect() got = %v, want %v", got, want)
+	}
+
+	if got, want := a.Difference(b), NewSet(DE); got != want {
+		t.Errorf("Difference() got = %v, want %v", got, want)
+	}
+
+	if a != NewSet(DE, FR, IT) {
+		t.Errorf("set algebra should not modify the receiver, got %v", a)
+	}
+
+	if !a.Difference(a).IsEmpty() || a.IsEmpty() {
+		t.Errorf("IsEmpty() got unexpected result")
+	}
+
+	var visited []CountryCode
+
+	a.Each(func(c CountryCode) bool {
+		visited = append(visited, c)
+
+		return len(visited) < 2
+	})
+
+	if want := a.Codes()[:2]; !reflect.DeepEqual(visited, want) {
+		t.Errorf("Each() visited = %v, want %v", visited, want)
+	}
+
+	allowed := map[CountrySet]string{NewSet(DE, FR): "core"}
+	if allowed[NewSet(FR, DE)] != "core" {
+		t.Errorf("sets should be usable as map keys")
+	}
+}
+
+func TestSet_Marshal(t *testing.T) {
+	type config struct {
+		Countries  CountrySet  `json:"countries"`
+		Currencies CurrencySet `json:"currencies"`
+	}
+
+	b, err := json.Marshal(config{Countries: NewSet(FR, DE, AD), Currencies: NewSet(USD, EUR)})
+	if err != nil || string(b) != `{"countries":["AD","DE","FR"],"currencies":["EUR","USD"]}` {
+		t.Errorf("Marshal() got = %s, %v", b, err)
+	}
+
+	if b, err := json.Marshal(config{}); err != nil || string(b) != `{"countries":[],"currencies":[]}` {
+		t.Errorf("Marshal() got = %s, %v, want empty arrays", b, err)
+	}
+
+	var got config
+	if err := json.Unmarshal([]byte(`{"countries":["GB","DE"],"currencies":null}`), &got); err != nil ||
+		got.Countries != NewSet(GB, DE) || !got.Currencies.IsEmpty() {
+		t.Errorf("Unmarshal() got = %+v, %v", got, err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"countries":["GB","XX"]}`), &got); !errors.Is(err, ErrUnmarshalJSON) {
+		t.Errorf("Unmarshal() error = %v, wantErr %v", err, ErrUnmarshalJSON)
+	}
+
+	if err := json.Unmarshal([]byte(`{"countries":"GB"}`), &got); !errors.Is(err, ErrUnmarshalJSON) {
+		t.Errorf("Unmarshal() error = %v, wantErr %v", err, ErrUnmarshalJSON)
+	}
+
+	var parseErr *ParseError
+	if err := json.Unmarshal([]byte(`{"countries":"`+strings.Repeat("GB", 1000)+`"}`), &got); errors.As(err, &parseErr) {
+		t.Errorf("Unmarshal() error = %v, want error without the input", err)
+	}
+
+	if _, err := json.Marshal(NewSet(CountryCode(250))); !errors.Is(err, ErrMarshalJSON) {
+		t.Errorf("Marshal() error = %v, wantErr %v", err, ErrMarshalJSON)
+	}
+
+	text, err := NewSet(GB, DE).MarshalText()
+	if err != nil || string(text) != "DE,GB" {
+		t.Errorf("MarshalText() got = %s, %v", text, err)
+	}
+
+	var fromText CountrySet
+	if err := fromText.UnmarshalText([]byte("GB, DE,")); err != nil || fromText != NewSet(GB, DE) {
+		t.Errorf("UnmarshalText() got = %v, %v", fromText, err)
+	}
+
+	if got := NewSet(GB, DE).String(); got != "DE,GB" {
+		t.Errorf("String() got = %v, want DE,GB", got)
+	}
+}
+
+func TestSet_SQL(t *testing.T) {
+	v, err := NewSet(USD, EUR).Value()
+	if err != nil || v != "EUR,USD" {
+		t.Errorf("Value() got = %v, %v", v, err)
+	}
+
+	if v, err := (CurrencySet{}).Value(); err != nil || v != nil {
+		t.Errorf("Value() got = %v, %v, want nil", v, err)
+	}
+
+	var got CurrencySet
+	if err := got.Scan([]byte("EUR,USD")); err != nil || got != NewSet(USD, EUR) {
+		t.Errorf("Scan() got = %v, %v", got, err)
+	}
+
+	if err := got.Scan(nil); err != nil || !got.IsEmpty() {
+		t.Errorf("Scan() got = %v, %v, want empty", got, err)
+	}
+
+	if err := got.Scan("EUR,QQQ"); !errors.Is(err, ErrScanSQL) {
+		t.Errorf("Scan() error = %v, wantErr %v", err, ErrScanSQL)
+	}
+
+	if err := got.Scan(42); !errors.Is(err, ErrScanSQL) {
+		t.Errorf("Scan() error = %v, wantErr %v", err, ErrScanSQL)
+	}
+}
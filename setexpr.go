@@ -0,0 +1,257 @@
+package isocodes
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// ParseCountrySet evaluates the country set expression and returns the resulting set.
+//
+// The expression consists of operands combined left to right by operators:
+//   - an Alpha2 country code, e.g. DE, reserved codes like UK are accepted;
+//   - a name of the built-in Group, e.g. EU or Schengen, case-insensitive;
+//   - * which stands for all countries;
+//   - a parenthesized expression, e.g. (EU - EEA).
+//
+// Operator + adds countries to the set and operator - removes them.
+// A comma separates operands like +, so a list can be written as "DE, FR"
+// and the operator may follow the comma, e.g. "*, -RU, -BY".
+// An empty expression results in an empty set.
+//
+// Groups are resolved to their current members, so the resulting set is
+// a snapshot which should be recomputed when group memberships change.
+//
+// Invalid expressions cause *SetExprError which wraps ErrInvalidSetExpression.
+func ParseCountrySet(expr string) (CountrySet, error) {
+	p := setExprParser{input: expr}
+	p.next()
+
+	if p.tok.kind == setTokenEOF {
+		return CountrySet{}, nil
+	}
+
+	set, err := p.parseExpr()
+	if err != nil {
+		return CountrySet{}, err
+	}
+
+	if p.tok.kind != setTokenEOF {
+		return CountrySet{}, p.errorf("unexpected %s, expected +, - or ,", p.tok)
+	}
+
+	return set, nil
+}
+
+// MustParseCountrySet is like ParseCountrySet but panics if the expression can't be parsed.
+// It simplifies safe initialization of global variables.
+func MustParseCountrySet(expr string) CountrySet {
+	set, err := ParseCountrySet(expr)
+	if err != nil {
+		panic(err)
+	}
+
+	return set
+}
+
+// SetExprError represents an error of the set expression parsing.
+type SetExprError struct {
+	// Expr holds the expression.
+	Expr string
+
+	// Pos holds 1-based byte position of the error in the expression.
+	Pos int
+
+	// Msg describes the error.
+	Msg string
+}
+
+// Error implements error interface.
+func (e *SetExprError) Error() string {
+	return fmt.Sprintf("%s at position %d: %s", ErrInvalidSetExpression, e.Pos, e.Msg)
+}
+
+// Unwrap returns ErrInvalidSetExpression.
+func (e *SetExprError) Unwrap() error { return ErrInvalidSetExpression }
+
+// setTokenKind represents a kind of the set expression token.
+type setTokenKind int
+
+const (
+	setTokenEOF setTokenKind = iota
+	setTokenIdent
+	setTokenAll
+	setTokenPlus
+	setTokenMinus
+	setTokenComma
+	setTokenLParen
+	setTokenRParen
+	setTokenInvalid
+)
+
+// setToken represents a token of the set expression.
+type setToken struct {
+	kind setTokenKind
+	text string
+	pos  int
+}
+
+// String returns the description of the token for error messages.
+func (t setToken) String() string {
+	if t.kind == setTokenEOF {
+		return "end of expression"
+	}
+
+	return fmt.Sprintf("%q", t.text)
+}
+
+// setExprOperators holds kinds of the single character tokens.
+var setExprOperators = map[byte]setTokenKind{
+	'*': setTokenAll, '+': setTokenPlus, '-': setTokenMinus,
+	',': setTokenComma, '(': setTokenLParen, ')': setTokenRParen,
+}
+
+// setExprParser is a recursive descent parser of the set expressions.
+type setExprParser struct {
+	input string
+	pos   int
+	tok   setToken
+}
+
+// next reads the next token.
+func (p *setExprParser) next() {
+	for p.pos < len(p.input) && (p.input[p.pos] == ' ' || p.input[p.pos] == '\t' || p.input[p.pos] == '\n') {
+		p.pos++
+	}
+
+	start := p.pos
+
+	if p.pos == len(p.input) {
+		p.tok = setToken{kind: setTokenEOF, pos: start}
+
+		return
+	}
+
+	if kind, ok := setExprOperators[p.input[p.pos]]; ok {
+		p.pos++
+		p.tok = setToken{kind: kind, text: p.input[start:p.pos], pos: start}
+
+		return
+	}
+
+	for p.pos < len(p.input) && isSetIdentChar(p.input[p.pos]) {
+		p.pos++
+	}
+
+	if p.pos > start {
+		p.tok = setToken{kind: setTokenIdent, text: p.input[start:p.pos], pos: start}
+
+		return
+	}
+
+	_, size := utf8.DecodeRuneInString(p.input[p.pos:])
+	p.pos += size
+	p.tok = setToken{kind: setTokenInvalid, text: p.input[start:p.pos], pos: start}
+}
+
+// parseExpr parses operands combined by operators.
+func (p *setExprParser) parseExpr() (CountrySet, error) {
+	var set CountrySet
+
+	op := setTokenPlus
+	if p.tok.kind == setTokenPlus || p.tok.kind == setTokenMinus {
+		op = p.tok.kind
+		p.next()
+	}
+
+	for {
+		operand, err := p.parseOperand()
+		if err != nil {
+			return CountrySet{}, err
+		}
+
+		if op == setTokenMinus {
+			set = set.Difference(operand)
+		} else {
+			set = set.Union(operand)
+		}
+
+		switch p.tok.kind {
+		case setTokenPlus, setTokenMinus:
+			op = p.tok.kind
+			p.next()
+		case setTokenComma:
+			op = setTokenPlus
+			p.next()
+
+			if p.tok.kind == setTokenPlus || p.tok.kind == setTokenMinus {
+				op = p.tok.kind
+				p.next()
+			}
+		default:
+			return set, nil
+		}
+	}
+}
+
+// parseOperand parses a country code, a group, * or a parenthesized expression.
+func (p *setExprParser) parseOperand() (CountrySet, error) {
+	switch tok := p.tok; tok.kind {
+	case setTokenAll:
+		p.next()
+
+		return NewSet(ListCountryCodes()...), nil
+	case setTokenIdent:
+		set, ok := resolveSetIdent(tok.text)
+		if !ok {
+			return CountrySet{}, p.errorf("unknown country code or group %q", tok.text)
+		}
+
+		p.next()
+
+		return set, nil
+	case setTokenLParen:
+		p.next()
+
+		set, err := p.parseExpr()
+		if err != nil {
+			return CountrySet{}, err
+		}
+
+		if p.tok.kind != setTokenRParen {
+			return CountrySet{}, p.errorf("unexpected %s, expected ) to close ( at position %d", p.tok, tok.pos+1)
+		}
+
+		p.next()
+
+		return set, nil
+	default:
+		return CountrySet{}, p.errorf("unexpected %s, expected country code, group, * or (", tok)
+	}
+}
+
+// errorf returns SetExprError at the position of the current token.
+func (p *setExprParser) errorf(format string, args ...any) *SetExprError {
+	return &SetExprError{Expr: p.input, Pos: p.tok.pos + 1, Msg: fmt.Sprintf(format, args...)}
+}
+
+// resolveSetIdent resolves the identifier to the group members or to the country code.
+func resolveSetIdent(ident string) (CountrySet, bool) {
+	for _, g := range ListGroups() {
+		if strings.EqualFold(string(g), ident) {
+			return NewSet(g.Countries()...), true
+		}
+	}
+
+	c, err := StringToCountryCode(ident, WithReservedCodes())
+	if err != nil {
+		return CountrySet{}, false
+	}
+
+	return NewSet(c), true
+}
+
+// isSetIdentChar reports whether the character can be a part of the set expression identifier.
+func isSetIdentChar(c byte) bool {
+	return c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c == '_'
+}
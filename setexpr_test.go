@@ -0,0 +1,86 @@
+package isocodes
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseCountrySet(t *testing.T) {
+	all := NewSet(ListCountryCodes()...)
+	eea := NewSet(GroupEEA.Countries()...)
+	eu := NewSet(GroupEU.Countries()...)
+
+	type tcase struct {
+		expr string
+		want CountrySet
+	}
+
+	tests := map[string]tcase{
+		"Groups":          {"EU + EEA - HU + CH", eea.Difference(NewSet(HU)).Union(NewSet(CH))},
+		"AllExcept":       {"*, -RU, -BY", all.Difference(NewSet(RU, BY))},
+		"List":            {"DE, FR,IT", NewSet(DE, FR, IT)},
+		"CaseInsensitive": {"schengen - eu", NewSet(GroupSchengen.Countries()...).Difference(eu)},
+		"Reserved":        {"UK + EL", NewSet(GB, GR)},
+		"Parentheses":     {"EEA - (EU - DE)", eea.Difference(eu).Union(NewSet(DE))},
+		"LeadingMinus":    {"-DE + FR", NewSet(FR)},
+		"OrderMatters":    {"DE - DE + DE", NewSet(DE)},
+		"Empty":           {"  ", CountrySet{}},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseCountrySet(tc.expr)
+			if err != nil || got != tc.want {
+				t.Errorf("ParseCountrySet() got = %v, %v, want %v", got, err, tc.want)
+			}
+		})
+	}
+}
+
+func TestParseCountrySet_Errors(t *testing.T) {
+	type tcase struct {
+		expr string
+		pos  int
+		msg  string
+	}
+
+	tests := map[string]tcase{
+		"Unknown":        {"EU + XX", 6, `unknown country code or group "XX"`},
+		"TrailingOp":     {"EU +", 5, "unexpected end of expression, expected country code, group, * or ("},
+		"MissingOp":      {"EU EEA", 4, `unexpected "EEA", expected +, - or ,`},
+		"Unclosed":       {"(EU - HU", 9, "unexpected end of expression, expected ) to close ( at position 1"},
+		"Unopened":       {"EU)", 3, `unexpected ")", expected +, - or ,`},
+		"InvalidChar":    {"EU & DE", 4, `unexpected "&", expected +, - or ,`},
+		"InvalidOperand": {"EU + ∅", 6, `unexpected "∅", expected country code, group, * or (`},
+		"DoubleComma":    {"DE,,FR", 4, `unexpected ",", expected country code, group, * or (`},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseCountrySet(tc.expr)
+
+			var exprErr *SetExprError
+			if !errors.As(err, &exprErr) || !errors.Is(err, ErrInvalidSetExpression) {
+				t.Fatalf("ParseCountrySet() error = %v, want *SetExprError", err)
+			}
+
+			if exprErr.Pos != tc.pos || exprErr.Msg != tc.msg {
+				t.Errorf("ParseCountrySet() error at %v: %v, want at %v: %v", exprErr.Pos, exprErr.Msg, tc.pos, tc.msg)
+			}
+		})
+	}
+}
+
+func TestMustParseCountrySet(t *testing.T) {
+	if got := MustParseCountrySet("DE + FR"); got != NewSet(DE, FR) {
+		t.Errorf("MustParseCountrySet() got = %v", got)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("MustParseCountrySet() should panic on invalid expression")
+		}
+	}()
+
+	MustParseCountrySet("DE +")
+}
@@ -0,0 +1,154 @@
+package isocodes
+
+import (
+	"strings"
+)
+
+// CodeStatus represents a status of the ISO 3166-1 Alpha2 code.
+type CodeStatus uint8
+
+const (
+	// StatusUnassigned - the code is not assigned and not reserved.
+	StatusUnassigned CodeStatus = iota
+
+	// StatusOfficiallyAssigned - the code is assigned to a country,
+	// territory or area of geographical interest.
+	StatusOfficiallyAssigned
+
+	// StatusUserAssigned - the code is free for the assignment by users:
+	// AA, QM-QZ, XA-XZ and ZZ.
+	StatusUserAssigned
+
+	// StatusExceptionallyReserved - the code is reserved on request
+	// of national ISO members, governments or international organizations, e.g. UK or EU.
+	StatusExceptionallyReserved
+
+	// StatusTransitionallyReserved - the code is deleted from ISO 3166-1,
+	// but reserved for a transitional period, e.g. YU.
+	StatusTransitionallyReserved
+
+	// StatusIndeterminatelyReserved - the code is used in coding systems
+	// associated with ISO 3166-1, e.g. vehicle registration codes like RA.
+	StatusIndeterminatelyReserved
+)
+
+func (s CodeStatus) String() string {
+	switch s {
+	case StatusUnassigned:
+		return "unassigned"
+	case StatusOfficiallyAssigned:
+		return "officially assigned"
+	case StatusUserAssigned:
+		return "user-assigned"
+	case StatusExceptionallyReserved:
+		return "exceptionally reserved"
+	case StatusTransitionallyReserved:
+		return "transitionally reserved"
+	case StatusIndeterminatelyReserved:
+		return "indeterminately reserved"
+	default:
+		return ""
+	}
+}
+
+// IsReserved reports whether the status is one of reserved statuses.
+func (s CodeStatus) IsReserved() bool {
+	return s == StatusExceptionallyReserved || s == StatusTransitionallyReserved || s == StatusIndeterminatelyReserved
+}
+
+// Status returns the status of the code.
+// Codes registered by RegisterCountryCode are user-assigned,
+// the zero value and invalid codes are unassigned.
+func (c CountryCode) Status() CodeStatus {
+	if _, ok := countryCodesDetails[c]; ok {
+		return StatusOfficiallyAssigned
+	}
+
+	if c.IsValid() {
+		return StatusUserAssigned
+	}
+
+	return StatusUnassigned
+}
+
+// CountryCodeStatus returns the status of the case-insensitive ISO 3166-1 Alpha2 code.
+// Unlike CountryCode.Status it handles reserved codes which can't be represented as CountryCode.
+func CountryCodeStatus(alpha2 string) CodeStatus {
+	alpha2 = strings.ToUpper(alpha2)
+
+	if _, ok := stringToCountryCode[alpha2]; ok {
+		return StatusOfficiallyAssigned
+	}
+
+	if status, ok := countryCodeStatuses[alpha2]; ok {
+		return status
+	}
+
+	if IsUserAssignedCountryCode(alpha2) {
+		return StatusUserAssigned
+	}
+
+	return StatusUnassigned
+}
+
+// countryCodeStatuses holds statuses of the reserved ISO 3166-1 Alpha2 codes.
+var countryCodeStatuses = map[string]CodeStatus{
+	// Exceptionally reserved.
+	"AC": StatusExceptionallyReserved, // Ascension Island.
+	"CP": StatusExceptionallyReserved, // Clipperton Island.
+	"CQ": StatusExceptionallyReserved, // Island of Sark.
+	"DG": StatusExceptionallyReserved, // Diego Garcia.
+	"EA": StatusExceptionallyReserved, // Ceuta, Melilla.
+	"EU": StatusExceptionallyReserved, // European Union.
+	"EZ": StatusExceptionallyReserved, // Eurozone.
+	"FX": StatusExceptionallyReserved, // France, Metropolitan.
+	"IC": StatusExceptionallyReserved, // Canary Islands.
+	"SU": StatusExceptionallyReserved, // USSR.
+	"TA": StatusExceptionallyReserved, // Tristan da Cunha.
+	"UK": StatusExceptionallyReserved, // United Kingdom.
+	"UN": StatusExceptionallyReserved, // United Nations.
+
+	// Transitionally reserved.
+	"AN": StatusTransitionallyReserved, // Netherlands Antilles.
+	"BU": StatusTransitionallyReserved, // Burma.
+	"CS": StatusTransitionallyReserved, // Serbia and Montenegro.
+	"NT": StatusTransitionallyReserved, // Neutral Zone.
+	"TP": StatusTransitionallyReserved, // East Timor.
+	"YU": StatusTransitionallyReserved, // Yugoslavia.
+
+	// Indeterminately reserved.
+	"DY": StatusIndeterminatelyReserved, // Benin.
+	"EW": StatusIndeterminatelyReserved, // Estonia.
+	"FL": StatusIndeterminatelyReserved, // Liechtenstein.
+	"JA": StatusIndeterminatelyReserved, // Jamaica.
+	"LF": StatusIndeterminatelyReserved, // Libya Fezzan.
+	"PI": StatusIndeterminatelyReserved, // Philippines.
+	"RA": StatusIndeterminatelyReserved, // Argentina.
+	"RB": StatusIndeterminatelyReserved, // Bolivia, Botswana.
+	"RC": StatusIndeterminatelyReserved, // China.
+	"RH": StatusIndeterminatelyReserved, // Haiti.
+	"RI": StatusIndeterminatelyReserved, // Indonesia.
+	"RL": StatusIndeterminatelyReserved, // Lebanon.
+	"RM": StatusIndeterminatelyReserved, // Madagascar.
+	"RN": StatusIndeterminatelyReserved, // Niger.
+	"RP": StatusIndeterminatelyReserved, // Philippines.
+	"WG": StatusIndeterminatelyReserved, // Grenada.
+	"WL": StatusIndeterminatelyReserved, // Saint Lucia.
+	"WV": StatusIndeterminatelyReserved, // Saint Vincent.
+	"YV": StatusIndeterminatelyReserved, // Venezuela.
+}
+
+// reservedCountryCodes maps reserved codes to officially assigned codes
+// they stand for. It is used by parsing WithReservedCodes option.
+var reservedCountryCodes = map[string]CountryCode{
+	"AC": SH,
+	"CP": FR,
+	"CQ": GG,
+	"DG": IO,
+	"EA": ES,
+	"EL": GR, // Used by the European Union for Greece, e.g. in VAT numbers.
+	"FX": FR,
+	"IC": ES,
+	"TA": SH,
+	"UK": GB,
+}
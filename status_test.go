@@ -0,0 +1,134 @@
+package isocodes
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCountryCode_Status(t *testing.T) {
+	resetRegistries(t)
+
+	xk, err := RegisterCountryCode(CountryCodeDetails{Alpha2: "XK", Name: "Kosovo"})
+	if err != nil {
+		t.Fatalf("RegisterCountryCode() unexpected error = %v", err)
+	}
+
+	type tcase struct {
+		code CountryCode
+		want CodeStatus
+	}
+
+	tests := map[string]tcase{
+		"GB":      {GB, StatusOfficiallyAssigned},
+		"XK":      {xk, StatusUserAssigned},
+		"Zero":    {UnknownCountry, StatusUnassigned},
+		"Invalid": {255, StatusUnassigned},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := tc.code.Status(); got != tc.want {
+				t.Errorf("Status() got = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCountryCodeStatus(t *testing.T) {
+	tests := map[string]CodeStatus{
+		"gb": StatusOfficiallyAssigned,
+		"QM": StatusUserAssigned,
+		"UK": StatusExceptionallyReserved,
+		"EU": StatusExceptionallyReserved,
+		"UN": StatusExceptionallyReserved,
+		"EA": StatusExceptionallyReserved,
+		"AN": StatusTransitionallyReserved,
+		"BU": StatusTransitionallyReserved,
+		"YU": StatusTransitionallyReserved,
+		"RA": StatusIndeterminatelyReserved,
+		"EL": StatusUnassigned,
+		"OO": StatusUnassigned,
+		"":   StatusUnassigned,
+	}
+
+	for code, want := range tests {
+		t.Run(code, func(t *testing.T) {
+			got := CountryCodeStatus(code)
+			if got != want {
+				t.Errorf("CountryCodeStatus() got = %v, want %v", got, want)
+			}
+
+			if got.IsReserved() != (want >= StatusExceptionallyReserved) {
+				t.Errorf("IsReserved() got = %v for %v", got.IsReserved(), got)
+			}
+		})
+	}
+}
+
+func TestCodeStatus_String(t *testing.T) {
+	tests := map[CodeStatus]string{
+		StatusUnassigned:              "unassigned",
+		StatusOfficiallyAssigned:      "officially assigned",
+		StatusUserAssigned:            "user-assigned",
+		StatusExceptionallyReserved:   "exceptionally reserved",
+		StatusTransitionallyReserved:  "transitionally reserved",
+		StatusIndeterminatelyReserved: "indeterminately reserved",
+		CodeStatus(42):                "",
+	}
+
+	for status, want := range tests {
+		t.Run(want, func(t *testing.T) {
+			if got := status.String(); got != want {
+				t.Errorf("String() got = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestWithReservedCodes(t *testing.T) {
+	type tcase struct {
+		code    string
+		opts    []ParseOption
+		want    CountryCode
+		wantErr error
+	}
+
+	tests := map[string]tcase{
+		"UK":          {"UK", []ParseOption{WithReservedCodes()}, GB, nil},
+		"EL":          {"el", []ParseOption{WithReservedCodes()}, GR, nil},
+		"IC":          {"IC", []ParseOption{WithReservedCodes()}, ES, nil},
+		"GB":          {"GB", []ParseOption{WithReservedCodes()}, GB, nil},
+		"EU":          {"EU", []ParseOption{WithReservedCodes()}, 0, ErrInvalidStringCode},
+		"UK_Disabled": {"UK", nil, 0, ErrInvalidStringCode},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := StringToCountryCode(tc.code, tc.opts...)
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Errorf("StringToCountryCode() error = %v, wantErr %v", err, tc.wantErr)
+				}
+			} else {
+				if got != tc.want {
+					t.Errorf("StringToCountryCode() got = %v, want %v", got, tc.want)
+				}
+			}
+		})
+	}
+
+	t.Run("Suggestion", func(t *testing.T) {
+		_, err := StringToCountryCode("UK")
+
+		var perr *ParseError
+		if !errors.As(err, &perr) || len(perr.Suggestions) == 0 || perr.Suggestions[0] != "GB" {
+			t.Errorf("StringToCountryCode() error = %v, want GB suggested first", err)
+		}
+	})
+
+	t.Run("Currency", func(t *testing.T) {
+		if _, err := StringToCurrencyCode("UKP", WithReservedCodes()); !errors.Is(err, ErrInvalidStringCode) {
+			t.Errorf("StringToCurrencyCode() error = %v, wantErr %v", err, ErrInvalidStringCode)
+		}
+	})
+}
@@ -0,0 +1,167 @@
+package isocodes
+
+import "sort"
+
+// Sovereign returns the sovereign state of the dependent territory,
+// e.g. DK for GL. Independent countries are sovereign themselves,
+// so for them it returns the country. For areas without a sovereign,
+// such as Antarctica and Western Sahara, it returns zero value.
+func (c CountryCode) Sovereign() CountryCode {
+	if t, ok := countryTerritories[c]; ok {
+		return t.sovereign
+	}
+
+	if !c.IsValid() {
+		return UnknownCountry
+	}
+
+	return c
+}
+
+// IsIndependent reports whether the country is a sovereign state,
+// rather than a dependent territory or an area without a sovereign.
+func (c CountryCode) IsIndependent() bool { return !c.IsZero() && c.Sovereign() == c }
+
+// IsIntegralPart reports whether the territory is an integral part of its sovereign
+// which has a code of its own, e.g. RE, which is an overseas department of France,
+// unlike GL, which is an autonomous territory of Denmark.
+func (c CountryCode) IsIntegralPart() bool {
+	return countryTerritories[c].flags&territoryIntegral != 0
+}
+
+// Territories returns dependent territories of the sovereign state
+// sorted by string representation, e.g. FO and GL for DK.
+func (c CountryCode) Territories() []CountryCode {
+	var territories []CountryCode
+
+	for t, entry := range countryTerritories {
+		if entry.sovereign == c && t != c {
+			territories = append(territories, t)
+		}
+	}
+
+	sort.Slice(territories, func(i, j int) bool { return territories[i].String() < territories[j].String() })
+
+	return territories
+}
+
+// InEUCustomsTerritory reports whether the country or territory is a part
+// of the customs territory of the European Union, which includes current
+// members of GroupEU, Monaco, Åland Islands and the French overseas departments.
+func (c CountryCode) InEUCustomsTerritory() bool {
+	return c.In(GroupEU) || countryTerritories[c].flags&territoryEUCustoms != 0
+}
+
+// InEUVATArea reports whether the country or territory is a part of the EU VAT area,
+// which includes current members of GroupEU and Monaco. Some territories inside
+// the customs territory, e.g. Åland Islands and the French overseas departments,
+// are outside the VAT area.
+func (c CountryCode) InEUVATArea() bool {
+	return c.In(GroupEU) || countryTerritories[c].flags&territoryEUVAT != 0
+}
+
+// IsEUOutermostRegion reports whether the territory is one of the outermost regions
+// of the European Union which has a code of its own, e.g. RE or GF.
+// Outermost regions without a code, e.g. Canary Islands, are parts of their sovereign.
+func (c CountryCode) IsEUOutermostRegion() bool {
+	return countryTerritories[c].flags&territoryEUOutermost != 0
+}
+
+// territoryFlags represents the status and the customs and tax treatment of the territory.
+type territoryFlags uint8
+
+const (
+	territoryIntegral territoryFlags = 1 << iota
+	territoryEUCustoms
+	territoryEUVAT
+	territoryEUOutermost
+)
+
+// territoryEntry represents the sovereignty of the territory.
+type territoryEntry struct {
+	sovereign CountryCode
+	flags     territoryFlags
+}
+
+// countryTerritories holds sovereignty of territories which have country codes,
+// as well as countries with a special EU customs and tax treatment.
+// Countries which aren't present are independent.
+var countryTerritories = map[CountryCode]territoryEntry{
+	// Areas without a sovereign.
+	AQ: {},
+	EH: {},
+
+	// Australia.
+	CC: {sovereign: AU},
+	CX: {sovereign: AU},
+	HM: {sovereign: AU},
+	NF: {sovereign: AU},
+
+	// China.
+	HK: {sovereign: CN},
+	MO: {sovereign: CN},
+
+	// Denmark.
+	FO: {sovereign: DK},
+	GL: {sovereign: DK},
+
+	// Finland.
+	AX: {sovereign: FI, flags: territoryIntegral | territoryEUCustoms},
+
+	// France.
+	BL: {sovereign: FR},
+	GF: {sovereign: FR, flags: territoryIntegral | territoryEUCustoms | territoryEUOutermost},
+	GP: {sovereign: FR, flags: territoryIntegral | territoryEUCustoms | territoryEUOutermost},
+	MF: {sovereign: FR, flags: territoryEUCustoms | territoryEUOutermost},
+	MQ: {sovereign: FR, flags: territoryIntegral | territoryEUCustoms | territoryEUOutermost},
+	NC: {sovereign: FR},
+	PF: {sovereign: FR},
+	PM: {sovereign: FR},
+	RE: {sovereign: FR, flags: territoryIntegral | territoryEUCustoms | territoryEUOutermost},
+	TF: {sovereign: FR},
+	WF: {sovereign: FR},
+	YT: {sovereign: FR, flags: territoryIntegral | territoryEUCustoms | territoryEUOutermost},
+
+	// Monaco is independent, but it's a part of the EU customs territory and VAT area.
+	MC: {sovereign: MC, flags: territoryEUCustoms | territoryEUVAT},
+
+	// Netherlands.
+	AW: {sovereign: NL},
+	BQ: {sovereign: NL, flags: territoryIntegral},
+	CW: {sovereign: NL},
+	SX: {sovereign: NL},
+
+	// New Zealand, including states in free association.
+	CK: {sovereign: NZ},
+	NU: {sovereign: NZ},
+	TK: {sovereign: NZ},
+
+	// Norway.
+	BV: {sovereign: NO},
+	SJ: {sovereign: NO, flags: territoryIntegral},
+
+	// United Kingdom.
+	AI: {sovereign: GB},
+	BM: {sovereign: GB},
+	FK: {sovereign: GB},
+	GG: {sovereign: GB},
+	GI: {sovereign: GB},
+	GS: {sovereign: GB},
+	IM: {sovereign: GB},
+	IO: {sovereign: GB},
+	JE: {sovereign: GB},
+	KY: {sovereign: GB},
+	MS: {sovereign: GB},
+	PN: {sovereign: GB},
+	SH: {sovereign: GB},
+	TC: {sovereign: GB},
+	VG: {sovereign: GB},
+
+	// United States.
+	AS: {sovereign: US},
+	GU: {sovereign: US},
+	MP: {sovereign: US},
+	PR: {sovereign: US},
+	UM: {sovereign: US},
+	VI: {sovereign: US},
+}
@@ -0,0 +1,114 @@
+package isocodes
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCountryCode_Sovereign(t *testing.T) {
+	type tcase struct {
+		code            CountryCode
+		wantSovereign   CountryCode
+		wantIndependent bool
+		wantIntegral    bool
+	}
+
+	tests := map[string]tcase{
+		"DE":   {DE, DE, true, false},
+		"GL":   {GL, DK, false, false},
+		"PR":   {PR, US, false, false},
+		"RE":   {RE, FR, false, true},
+		"BV":   {BV, NO, false, false},
+		"HK":   {HK, CN, false, false},
+		"AX":   {AX, FI, false, true},
+		"MC":   {MC, MC, true, false},
+		"AQ":   {AQ, UnknownCountry, false, false},
+		"Zero": {UnknownCountry, UnknownCountry, false, false},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := tc.code.Sovereign(); got != tc.wantSovereign {
+				t.Errorf("Sovereign() got = %v, want %v", got, tc.wantSovereign)
+			}
+
+			if got := tc.code.IsIndependent(); got != tc.wantIndependent {
+				t.Errorf("IsIndependent() got = %v, want %v", got, tc.wantIndependent)
+			}
+
+			if got := tc.code.IsIntegralPart(); got != tc.wantIntegral {
+				t.Errorf("IsIntegralPart() got = %v, want %v", got, tc.wantIntegral)
+			}
+		})
+	}
+
+	t.Run("SovereignsAreIndependent", func(t *testing.T) {
+		for c, entry := range countryTerritories {
+			if !entry.sovereign.IsZero() && !entry.sovereign.IsIndependent() {
+				t.Errorf("sovereign %v of %v is not independent", entry.sovereign, c)
+			}
+		}
+	})
+}
+
+func TestCountryCode_Territories(t *testing.T) {
+	type tcase struct {
+		code CountryCode
+		want []CountryCode
+	}
+
+	tests := map[string]tcase{
+		"DK": {DK, []CountryCode{FO, GL}},
+		"CN": {CN, []CountryCode{HK, MO}},
+		"NL": {NL, []CountryCode{AW, BQ, CW, SX}},
+		"MC": {MC, nil},
+		"DE": {DE, nil},
+		"GL": {GL, nil},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := tc.code.Territories(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("Territories() got = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCountryCode_EUTreatment(t *testing.T) {
+	type tcase struct {
+		code          CountryCode
+		wantCustoms   bool
+		wantVAT       bool
+		wantOutermost bool
+	}
+
+	tests := map[string]tcase{
+		"DE": {DE, true, true, false},
+		"FR": {FR, true, true, false},
+		"GB": {GB, false, false, false},
+		"MC": {MC, true, true, false},
+		"AX": {AX, true, false, false},
+		"RE": {RE, true, false, true},
+		"MF": {MF, true, false, true},
+		"GL": {GL, false, false, false},
+		"NC": {NC, false, false, false},
+		"CH": {CH, false, false, false},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := tc.code.InEUCustomsTerritory(); got != tc.wantCustoms {
+				t.Errorf("InEUCustomsTerritory() got = %v, want %v", got, tc.wantCustoms)
+			}
+
+			if got := tc.code.InEUVATArea(); got != tc.wantVAT {
+				t.Errorf("InEUVATArea() got = %v, want %v", got, tc.wantVAT)
+			}
+
+			if got := tc.code.IsEUOutermostRegion(); got != tc.wantOutermost {
+				t.Errorf("IsEUOutermostRegion() got = %v, want %v", got, tc.wantOutermost)
+			}
+		})
+	}
+}
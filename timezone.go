@@ -0,0 +1,107 @@
+package isocodes
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+//go:generate go run gen_tz.go -zones data/zone.tab -links data/tzlinks.zi -out timezone_data.go
+
+// TimeZones returns IANA time zone names of the country, the primary zone first,
+// e.g. "Europe/Kyiv" and "Europe/Simferopol" for UA. For countries without
+// time zones in the IANA database, e.g. BV, it returns nil.
+func (c CountryCode) TimeZones() []string {
+	zones := countryTimeZones[c]
+	if zones == nil {
+		return nil
+	}
+
+	return append([]string(nil), zones...)
+}
+
+// PrimaryTimeZone returns the IANA name of the primary time zone of the country,
+// which is the zone of its capital, e.g. "Europe/Moscow" for RU.
+func (c CountryCode) PrimaryTimeZone() string {
+	if zones := countryTimeZones[c]; len(zones) > 0 {
+		return zones[0]
+	}
+
+	return ""
+}
+
+// Location loads the primary time zone of the country. Time zones are loaded
+// by time.LoadLocation, so the time zone database must be available
+// on the system or embedded into the program by importing time/tzdata.
+// Countries without time zones cause an error which wraps ErrUnknownTimeZone.
+func (c CountryCode) Location() (*time.Location, error) {
+	name := c.PrimaryTimeZone()
+	if name == "" {
+		return nil, c.timeZoneError()
+	}
+
+	return time.LoadLocation(name)
+}
+
+// Locations loads all time zones of the country in the order of TimeZones.
+// Like Location, it requires the time zone database.
+func (c CountryCode) Locations() ([]*time.Location, error) {
+	zones := countryTimeZones[c]
+	if len(zones) == 0 {
+		return nil, c.timeZoneError()
+	}
+
+	locations := make([]*time.Location, len(zones))
+
+	for i, name := range zones {
+		loc, err := time.LoadLocation(name)
+		if err != nil {
+			return nil, err
+		}
+
+		locations[i] = loc
+	}
+
+	return locations, nil
+}
+
+// CountryForTimeZone returns the country of the IANA time zone,
+// e.g. UA for "Europe/Kyiv". The name is case-insensitive and backward
+// compatible names, such as "Europe/Kiev", are recognized as well.
+// Zones which don't belong to a country, e.g. "UTC",
+// cause *ParseError which wraps ErrUnknownTimeZone.
+func CountryForTimeZone(name string) (CountryCode, error) {
+	timeZonesIndexOnce.Do(buildTimeZonesIndex)
+
+	if c, ok := timeZonesIndex[strings.ToLower(name)]; ok {
+		return c, nil
+	}
+
+	return 0, &ParseError{Input: name, Type: CodeTypeCountry, Format: FormatIANATimeZone, Err: ErrUnknownTimeZone}
+}
+
+// timeZoneError returns the error for the country without time zones.
+func (c CountryCode) timeZoneError() error {
+	return &ParseError{Input: c.String(), Type: CodeTypeCountry, Format: FormatIANATimeZone, Err: ErrUnknownTimeZone}
+}
+
+var (
+	// timeZonesIndex holds countries keyed by lower-cased zone names and links.
+	timeZonesIndex     map[string]CountryCode
+	timeZonesIndexOnce sync.Once
+)
+
+// buildTimeZonesIndex builds the reverse index of countryTimeZones.
+func buildTimeZonesIndex() {
+	timeZonesIndex = make(map[string]CountryCode, len(countryTimeZones)+len(timeZoneLinks))
+
+	for c, zones := range countryTimeZones {
+		for _, name := range zones {
+			timeZonesIndex[strings.ToLower(name)] = c
+		}
+	}
+
+	for link, target := range timeZoneLinks {
+		timeZonesIndex[strings.ToLower(link)] = timeZonesIndex[strings.ToLower(target)]
+	}
+}
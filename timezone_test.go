@@ -0,0 +1,209 @@
+package isocodes
+
+import (
+	"bufio"
+	"errors"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+	_ "time/tzdata"
+)
+
+func TestCountryCode_TimeZones(t *testing.T) {
+	type tcase struct {
+		code        CountryCode
+		wantPrimary string
+		wantLen     int
+	}
+
+	tests := map[string]tcase{
+		"DE":   {DE, "Europe/Berlin", 2},
+		"UA":   {UA, "Europe/Kyiv", 2},
+		"RU":   {RU, "Europe/Moscow", 26},
+		"US":   {US, "America/New_York", 29},
+		"AU":   {AU, "Australia/Sydney", 12},
+		"JP":   {JP, "Asia/Tokyo", 1},
+		"BV":   {BV, "", 0},
+		"Zero": {UnknownCountry, "", 0},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			zones := tc.code.TimeZones()
+			if len(zones) != tc.wantLen {
+				t.Errorf("TimeZones() got = %v, want %d zones", zones, tc.wantLen)
+			}
+
+			if got := tc.code.PrimaryTimeZone(); got != tc.wantPrimary {
+				t.Errorf("PrimaryTimeZone() got = %v, want %v", got, tc.wantPrimary)
+			}
+
+			if len(zones) > 0 && zones[0] != tc.wantPrimary {
+				t.Errorf("TimeZones()[0] got = %v, want %v", zones[0], tc.wantPrimary)
+			}
+		})
+	}
+
+	t.Run("Copy", func(t *testing.T) {
+		DE.TimeZones()[0] = "Mars/Base"
+
+		if got := DE.PrimaryTimeZone(); got != "Europe/Berlin" {
+			t.Errorf("PrimaryTimeZone() after modification got = %v", got)
+		}
+	})
+}
+
+func TestCountryForTimeZone(t *testing.T) {
+	type tcase struct {
+		name    string
+		want    CountryCode
+		wantErr error
+	}
+
+	tests := map[string]tcase{
+		"Kyiv":       {"Europe/Kyiv", UA, nil},
+		"Kiev":       {"Europe/Kiev", UA, nil},
+		"Lower":      {"europe/berlin", DE, nil},
+		"Simferopol": {"Europe/Simferopol", UA, nil},
+		"Vaduz":      {"Europe/Vaduz", LI, nil},
+		"Link":       {"US/Eastern", US, nil},
+		"UTC":        {"UTC", 0, ErrUnknownTimeZone},
+		"Unknown":    {"Mars/Base", 0, ErrUnknownTimeZone},
+		"Empty":      {"", 0, ErrUnknownTimeZone},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := CountryForTimeZone(tc.name)
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Errorf("CountryForTimeZone() error = %v, wantErr %v", err, tc.wantErr)
+				}
+			} else {
+				if err != nil || got != tc.want {
+					t.Errorf("CountryForTimeZone() got = %v, %v, want %v", got, err, tc.want)
+				}
+			}
+		})
+	}
+
+	t.Run("RoundTrip", func(t *testing.T) {
+		for c, zones := range countryTimeZones {
+			for _, name := range zones {
+				if got, err := CountryForTimeZone(name); err != nil || got != c {
+					t.Errorf("CountryForTimeZone(%v) got = %v, %v, want %v", name, got, err, c)
+				}
+			}
+		}
+	})
+}
+
+func TestCountryCode_Locations(t *testing.T) {
+	t.Run("Primary", func(t *testing.T) {
+		loc, err := UA.Location()
+		if err != nil || loc.String() != "Europe/Kyiv" {
+			t.Errorf("Location() got = %v, %v, want Europe/Kyiv", loc, err)
+		}
+	})
+
+	t.Run("All", func(t *testing.T) {
+		for _, c := range ListCountryCodes() {
+			if c.PrimaryTimeZone() == "" {
+				continue
+			}
+
+			locations, err := c.Locations()
+			if err != nil {
+				t.Fatalf("Locations() of %v error = %v", c, err)
+			}
+
+			names := make([]string, len(locations))
+			for i, loc := range locations {
+				names[i] = loc.String()
+			}
+
+			if !reflect.DeepEqual(names, c.TimeZones()) {
+				t.Errorf("Locations() of %v got = %v, want %v", c, names, c.TimeZones())
+			}
+		}
+	})
+
+	t.Run("Links", func(t *testing.T) {
+		for link := range timeZoneLinks {
+			if _, err := time.LoadLocation(link); err != nil {
+				t.Errorf("LoadLocation(%v) error = %v", link, err)
+			}
+		}
+	})
+
+	t.Run("NoTimeZones", func(t *testing.T) {
+		if _, err := BV.Location(); !errors.Is(err, ErrUnknownTimeZone) {
+			t.Errorf("Location() error = %v, wantErr %v", err, ErrUnknownTimeZone)
+		}
+
+		if _, err := BV.Locations(); !errors.Is(err, ErrUnknownTimeZone) {
+			t.Errorf("Locations() error = %v, wantErr %v", err, ErrUnknownTimeZone)
+		}
+	})
+}
+
+// TestTimeZones_Zone1970 validates time zones against zone1970.tab, which lists
+// zones that differ since 1970 along with all countries which use them.
+func TestTimeZones_Zone1970(t *testing.T) {
+	f, err := os.Open("data/zone1970.tab")
+	if err != nil {
+		t.Fatalf("open zone1970.tab: %v", err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Split(line, "\t")
+		codes, zone := strings.Split(fields[0], ","), fields[2]
+
+		for _, code := range codes {
+			c, err := StringToCountryCode(code)
+			if err != nil {
+				t.Errorf("zone %v: %v", zone, err)
+
+				continue
+			}
+
+			if len(c.TimeZones()) == 0 {
+				t.Errorf("TimeZones() of %v is empty, but it uses %v", c, zone)
+			}
+		}
+
+		if _, err := time.LoadLocation(zone); err != nil {
+			t.Errorf("LoadLocation(%v) error = %v", zone, err)
+		}
+
+		// Countries follow zone.tab, which assigns a single country to the zone,
+		// e.g. UA to Europe/Simferopol, which zone1970.tab lists as used by RU and UA.
+		if got, err := CountryForTimeZone(zone); err != nil || !containsString(codes, got.String()) {
+			t.Errorf("CountryForTimeZone(%v) got = %v, %v, want one of %v", zone, got, err, codes)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		t.Fatalf("read zone1970.tab: %v", err)
+	}
+}
+
+func containsString(s []string, v string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,134 @@
+package isocodes
+
+import (
+	"net"
+	"strings"
+)
+
+// TLD returns the country code top-level domain of the country, e.g. ".de".
+// Most of the ccTLDs are derived from the Alpha2 code with the exception
+// of GB, which uses ".uk". Countries without delegated ccTLD,
+// e.g. Bonaire, Sint Eustatius and Saba, return an empty string.
+func (c CountryCode) TLD() string {
+	if tld, ok := countryTLDExceptions[c]; ok {
+		return tld
+	}
+
+	if _, ok := countryCodesDetails[c]; !ok {
+		return ""
+	}
+
+	return "." + strings.ToLower(c.String())
+}
+
+// CountryFromTLD returns the country of the case-insensitive country code
+// top-level domain, e.g. ".de" or "de". Besides the ccTLDs returned by
+// CountryCode.TLD it recognizes ".gb" and ".ac" of Ascension Island,
+// and internationalized ccTLDs in punycode, e.g. "xn--p1ai" of Russia.
+// Unknown domains cause *ParseError which wraps ErrUnknownTLD.
+func CountryFromTLD(tld string) (CountryCode, error) {
+	label := strings.TrimPrefix(strings.ToLower(tld), ".")
+
+	if c, ok := tldCountry(label); ok {
+		return c, nil
+	}
+
+	return 0, &ParseError{Input: tld, Type: CodeTypeCountry, Format: FormatTLD, Err: ErrUnknownTLD}
+}
+
+// CountryFromHost returns the country of the host name, e.g. "shop.example.co.jp"
+// returns JP. The host may contain a port and a trailing dot.
+// Second-level domains under generic TLDs which are used as country
+// domains, e.g. "example.uk.com", are recognized as well.
+// Hosts without country domain, e.g. "example.com" or IP addresses,
+// cause *ParseError which wraps ErrUnknownTLD.
+func CountryFromHost(host string) (CountryCode, error) {
+	name := strings.ToLower(host)
+
+	if h, _, err := net.SplitHostPort(name); err == nil {
+		name = h
+	}
+
+	name = strings.TrimSuffix(name, ".")
+
+	if net.ParseIP(name) == nil {
+		labels := strings.Split(name, ".")
+
+		if len(labels) >= 3 {
+			if c, ok := secondLevelCountryDomains[strings.Join(labels[len(labels)-2:], ".")]; ok {
+				return c, nil
+			}
+		}
+
+		if len(labels) >= 2 {
+			if c, ok := tldCountry(labels[len(labels)-1]); ok {
+				return c, nil
+			}
+		}
+	}
+
+	return 0, &ParseError{Input: host, Type: CodeTypeCountry, Format: FormatTLD, Err: ErrUnknownTLD}
+}
+
+// tldCountry returns the country of the lower case top-level domain label.
+func tldCountry(label string) (CountryCode, bool) {
+	if c, ok := tldCountryAliases[label]; ok {
+		return c, true
+	}
+
+	if len(label) != 2 {
+		return 0, false
+	}
+
+	c, ok := stringToCountryCode[strings.ToUpper(label)]
+	if !ok || c.TLD() != "."+label {
+		return 0, false
+	}
+
+	return c, true
+}
+
+// countryTLDExceptions holds ccTLDs which are not derived from the Alpha2 code.
+var countryTLDExceptions = map[CountryCode]string{
+	GB: ".uk",
+	BL: "",
+	BQ: "",
+	EH: "",
+	MF: "",
+	UM: "",
+}
+
+// tldCountryAliases holds top-level domains which are not returned by
+// CountryCode.TLD, but are associated with the country.
+var tldCountryAliases = map[string]CountryCode{
+	"uk": GB,
+	"gb": GB,
+	"ac": SH,
+
+	// Internationalized ccTLDs.
+	"xn--p1ai":          RU, // .рф
+	"xn--j1amh":         UA, // .укр
+	"xn--90ais":         BY, // .бел
+	"xn--d1alf":         MK, // .мкд
+	"xn--90a3ac":        RS, // .срб
+	"xn--fiqs8s":        CN, // .中国
+	"xn--fiqz9s":        CN, // .中國
+	"xn--j6w193g":       HK, // .香港
+	"xn--kprw13d":       TW, // .台湾
+	"xn--kpry57d":       TW, // .台灣
+	"xn--3e0b707e":      KR, // .한국
+	"xn--o3cw4h":        TH, // .ไทย
+	"xn--h2brj9c":       IN, // .भारत
+	"xn--yfro4i67o":     SG, // .新加坡
+	"xn--wgbh1c":        EG, // .مصر
+	"xn--mgbaam7a8h":    AE, // .امارات
+	"xn--mgberp4a5d4ar": SA, // .السعودية
+}
+
+// secondLevelCountryDomains holds public suffixes under generic TLDs
+// which are commonly used as country domains.
+var secondLevelCountryDomains = map[string]CountryCode{
+	"br.com": BR, "cn.com": CN, "de.com": DE, "gb.com": GB, "gb.net": GB, "jp.net": JP,
+	"jpn.com": JP, "ru.com": RU, "sa.com": SA, "se.net": SE, "uk.com": GB, "uk.net": GB,
+	"us.com": US, "us.org": US, "za.com": ZA, "hu.net": HU, "in.net": IN, "ae.org": AE,
+}
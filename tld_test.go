@@ -0,0 +1,114 @@
+package isocodes
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCountryCode_TLD(t *testing.T) {
+	type tcase struct {
+		code CountryCode
+		want string
+	}
+
+	tests := map[string]tcase{
+		"DE":   {DE, ".de"},
+		"GB":   {GB, ".uk"},
+		"JP":   {JP, ".jp"},
+		"BQ":   {BQ, ""},
+		"Zero": {UnknownCountry, ""},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := tc.code.TLD(); got != tc.want {
+				t.Errorf("TLD() got = %v, want %v", got, tc.want)
+			}
+		})
+	}
+
+	t.Run("RoundTrip", func(t *testing.T) {
+		for _, c := range ListCountryCodes() {
+			if c.TLD() == "" {
+				continue
+			}
+
+			if got, err := CountryFromTLD(c.TLD()); err != nil || got != c {
+				t.Errorf("CountryFromTLD(%v) got = %v, %v, want %v", c.TLD(), got, err, c)
+			}
+		}
+	})
+}
+
+func TestCountryFromTLD(t *testing.T) {
+	type tcase struct {
+		tld     string
+		want    CountryCode
+		wantErr error
+	}
+
+	tests := map[string]tcase{
+		".de":      {".de", DE, nil},
+		"DE":       {"DE", DE, nil},
+		".uk":      {".uk", GB, nil},
+		".gb":      {".gb", GB, nil},
+		".ac":      {".ac", SH, nil},
+		"xn--p1ai": {"xn--p1ai", RU, nil},
+		".com":     {".com", 0, ErrUnknownTLD},
+		".bq":      {".bq", 0, ErrUnknownTLD},
+		".eu":      {".eu", 0, ErrUnknownTLD},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := CountryFromTLD(tc.tld)
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Errorf("CountryFromTLD() error = %v, wantErr %v", err, tc.wantErr)
+				}
+			} else {
+				if got != tc.want {
+					t.Errorf("CountryFromTLD() got = %v, want %v", got, tc.want)
+				}
+			}
+		})
+	}
+}
+
+func TestCountryFromHost(t *testing.T) {
+	type tcase struct {
+		host    string
+		want    CountryCode
+		wantErr error
+	}
+
+	tests := map[string]tcase{
+		"SecondLevel":   {"shop.example.co.jp", JP, nil},
+		"UK":            {"www.example.co.uk", GB, nil},
+		"Port":          {"example.de:8080", DE, nil},
+		"TrailingDot":   {"Example.FR.", FR, nil},
+		"PseudoCCTLD":   {"shop.example.uk.com", GB, nil},
+		"IDN":           {"пример.xn--p1ai", RU, nil},
+		"Generic":       {"example.com", 0, ErrUnknownTLD},
+		"GenericUK":     {"uk.com", 0, ErrUnknownTLD},
+		"SingleLabel":   {"de", 0, ErrUnknownTLD},
+		"IPv4":          {"192.168.0.1", 0, ErrUnknownTLD},
+		"IPv6WithPort":  {"[::1]:443", 0, ErrUnknownTLD},
+		"EmailDomainGB": {"mail.example.ac.uk", GB, nil},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := CountryFromHost(tc.host)
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Errorf("CountryFromHost() error = %v, wantErr %v", err, tc.wantErr)
+				}
+			} else {
+				if err != nil || got != tc.want {
+					t.Errorf("CountryFromHost() got = %v, %v, want %v", got, err, tc.want)
+				}
+			}
+		})
+	}
+}
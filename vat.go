@@ -0,0 +1,754 @@
+package isocodes
+
+import (
+	"bytes"
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// VATNumber represents EU VAT identification number in the normalized form,
+// which is the 2 letters prefix followed by the national number without
+// separators, e.g. "DE136695976". Use ParseVATNumber to obtain a valid
+// VATNumber from the user input.
+type VATNumber string
+
+// ParseVATNumber takes case-insensitive VAT identification number with the
+// prefix, validates its format and the check digits according to the rules
+// of the member state and returns the normalized VATNumber.
+// Spaces, dots and dashes are ignored.
+//
+// Greece uses EL prefix instead of GR and Northern Ireland uses XI prefix,
+// which both are resolved to the country code by VATNumber.Country.
+//
+// Invalid VAT numbers cause *ParseError which wraps ErrInvalidVATNumber
+// or ErrUnsupportedVATPrefix.
+func ParseVATNumber(s string) (VATNumber, error) {
+	vat := strings.ToUpper(strings.NewReplacer(" ", "", ".", "", "-", "").Replace(s))
+
+	if len(vat) < 3 {
+		return "", vatParseError(s, ErrInvalidVATNumber)
+	}
+
+	rule, ok := vatRules[vat[:2]]
+	if !ok {
+		return "", vatParseError(s, ErrUnsupportedVATPrefix)
+	}
+
+	n := vat[2:]
+	if len(n) == rule.length-1 {
+		n = "0" + n
+	}
+
+	if !rule.validate(n) {
+		return "", vatParseError(s, ErrInvalidVATNumber)
+	}
+
+	return VATNumber(vat[:2] + n), nil
+}
+
+// ValidateVATNumber validates VAT identification number
+// like ParseVATNumber and returns the resolved country.
+func ValidateVATNumber(s string) (CountryCode, error) {
+	vat, err := ParseVATNumber(s)
+	if err != nil {
+		return UnknownCountry, err
+	}
+
+	return vat.Country(), nil
+}
+
+// VATPrefixes returns VAT number prefixes of the EU member states
+// and Northern Ireland sorted alphabetically.
+func VATPrefixes() []string {
+	prefixes := make([]string, 0, len(vatRules))
+
+	for prefix := range vatRules {
+		prefixes = append(prefixes, prefix)
+	}
+
+	sort.Strings(prefixes)
+
+	return prefixes
+}
+
+// String returns string representation of the VAT number.
+func (v VATNumber) String() string { return string(v) }
+
+// IsValid reports whether the VAT number is valid.
+func (v VATNumber) IsValid() bool {
+	_, err := ParseVATNumber(string(v))
+
+	return err == nil
+}
+
+// Prefix returns the 2 letters prefix of the VAT number, e.g. "EL".
+func (v VATNumber) Prefix() string {
+	if len(v) < 2 {
+		return ""
+	}
+
+	return string(v[:2])
+}
+
+// Number returns the national part of the VAT number without the prefix.
+func (v VATNumber) Number() string {
+	if len(v) < 2 {
+		return ""
+	}
+
+	return string(v[2:])
+}
+
+// Country returns the country of the VAT number,
+// e.g. GR for EL prefix and GB for XI prefix.
+func (v VATNumber) Country() CountryCode { return vatRules[v.Prefix()].country }
+
+// MarshalJSON implements json.Marshaler.
+// The zero value is marshalled as JSON null.
+func (v VATNumber) MarshalJSON() ([]byte, error) { return marshalIdentifierJSON(v) }
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *VATNumber) UnmarshalJSON(b []byte) error {
+	return unmarshalIdentifierJSON(v, b, ParseVATNumber)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// The zero value is marshalled as an empty text.
+func (v VATNumber) MarshalText() ([]byte, error) { return marshalIdentifierText(v) }
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// An empty text is unmarshalled into the zero value.
+func (v *VATNumber) UnmarshalText(b []byte) error {
+	return parseIdentifier(v, string(b), ParseVATNumber)
+}
+
+// Scan implements sql.Scanner.
+// SQL NULL and an empty string are scanned into the zero value.
+func (v *VATNumber) Scan(src any) error { return scanIdentifierSQL(v, src, ParseVATNumber) }
+
+// Value implements driver.Valuer.
+// The zero value is stored as SQL NULL.
+func (v VATNumber) Value() (driver.Value, error) { return valueIdentifierSQL(v) }
+
+// VATCheckResult represents the result of the online VAT number check.
+type VATCheckResult struct {
+	Valid   bool   `json:"valid"`
+	Name    string `json:"name"`
+	Address string `json:"address"`
+}
+
+// VATChecker checks whether the VAT number is registered,
+// e.g. by the VIES service of the European Commission.
+// Tests can use a local stub instead of the online service.
+type VATChecker interface {
+	CheckVAT(ctx context.Context, vat VATNumber) (VATCheckResult, error)
+}
+
+// VATCheckerFunc is an adapter to allow the use of ordinary functions as VATChecker.
+type VATCheckerFunc func(ctx context.Context, vat VATNumber) (VATCheckResult, error)
+
+// CheckVAT calls f(ctx, vat).
+func (f VATCheckerFunc) CheckVAT(ctx context.Context, vat VATNumber) (VATCheckResult, error) {
+	return f(ctx, vat)
+}
+
+// VATCheckError represents an error of the online VAT number check.
+type VATCheckError struct {
+	// Err holds the cause of the error, e.g. the transport error.
+	Err error
+}
+
+// Error implements error interface.
+func (e *VATCheckError) Error() string { return ErrVATCheck.Error() + ": " + e.Err.Error() }
+
+// Unwrap returns the cause of the error.
+func (e *VATCheckError) Unwrap() error { return e.Err }
+
+// Is reports whether the target is ErrVATCheck.
+func (e *VATCheckError) Is(target error) bool { return target == ErrVATCheck }
+
+// CheckVATNumber validates VAT number offline by ParseVATNumber and
+// then checks it with the checker. The checker is not called for
+// VAT numbers which fail the offline validation.
+func CheckVATNumber(ctx context.Context, checker VATChecker, s string) (VATCheckResult, error) {
+	vat, err := ParseVATNumber(s)
+	if err != nil {
+		return VATCheckResult{}, err
+	}
+
+	return checker.CheckVAT(ctx, vat)
+}
+
+// VIESURL holds the URL of the VIES REST API of the European Commission.
+const VIESURL = "https://ec.europa.eu/taxation_customs/vies/rest-api/check-vat-number"
+
+// VIESChecker implements VATChecker using the VIES REST API.
+// The zero value uses http.DefaultClient and VIESURL.
+type VIESChecker struct {
+	// Client is used to send requests. If nil, http.DefaultClient is used.
+	Client *http.Client
+
+	// URL of the VIES check VAT number endpoint. If empty, VIESURL is used.
+	URL string
+}
+
+// CheckVAT implements VATChecker.
+// Failed checks cause *VATCheckError which wraps ErrVATCheck
+// and the cause, e.g. context.DeadlineExceeded.
+func (c VIESChecker) CheckVAT(ctx context.Context, vat VATNumber) (VATCheckResult, error) {
+	client, url := c.Client, c.URL
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	if url == "" {
+		url = VIESURL
+	}
+
+	body, err := json.Marshal(map[string]string{"countryCode": vat.Prefix(), "vatNumber": vat.Number()})
+	if err != nil {
+		return VATCheckResult{}, &VATCheckError{Err: err}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return VATCheckResult{}, &VATCheckError{Err: err}
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return VATCheckResult{}, &VATCheckError{Err: err}
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return VATCheckResult{}, &VATCheckError{Err: fmt.Errorf("unexpected status %s", resp.Status)}
+	}
+
+	var result VATCheckResult
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return VATCheckResult{}, &VATCheckError{Err: err}
+	}
+
+	return result, nil
+}
+
+// parseError returns ParseError for the invalid VAT number with the error.
+func (VATNumber) parseError(input string, err error) *ParseError { return vatParseError(input, err) }
+
+// vatParseError returns ParseError for the invalid VAT number.
+func vatParseError(input string, err error) *ParseError {
+	return &ParseError{Input: input, Type: CodeTypeVATNumber, Format: FormatEUVAT, Err: err}
+}
+
+// vatRule represents the VAT number rule of the member state.
+type vatRule struct {
+	country  CountryCode
+	validate func(n string) bool
+
+	// length holds the length of numbers which are written
+	// without the leading zero sometimes, otherwise zero.
+	length int
+}
+
+// vatRules holds VAT number rules keyed by the VAT number prefix.
+var vatRules = map[string]vatRule{
+	"AT": {AT, vatAT, 0},
+	"BE": {BE, vatBE, 10},
+	"BG": {BG, vatBG, 0},
+	"CY": {CY, vatCY, 0},
+	"CZ": {CZ, vatCZ, 0},
+	"DE": {DE, vatDE, 0},
+	"DK": {DK, vatDK, 0},
+	"EE": {EE, vatEE, 0},
+	"EL": {GR, vatEL, 9},
+	"ES": {ES, vatES, 0},
+	"FI": {FI, vatFI, 0},
+	"FR": {FR, vatFR, 0},
+	"HR": {HR, vatHR, 0},
+	"HU": {HU, vatHU, 0},
+	"IE": {IE, vatIE, 0},
+	"IT": {IT, vatIT, 0},
+	"LT": {LT, vatLT, 0},
+	"LU": {LU, vatLU, 0},
+	"LV": {LV, vatLV, 0},
+	"MT": {MT, vatMT, 0},
+	"NL": {NL, vatNL, 0},
+	"PL": {PL, vatPL, 0},
+	"PT": {PT, vatPT, 0},
+	"RO": {RO, vatRO, 0},
+	"SE": {SE, vatSE, 0},
+	"SI": {SI, vatSI, 0},
+	"SK": {SK, vatSK, 0},
+	"XI": {GB, vatXI, 0},
+}
+
+// weightedSum returns the sum of digits of n multiplied by the weights.
+// n must consist of digits and be at least as long as weights.
+func weightedSum(n string, weights ...int) int {
+	sum := 0
+
+	for i, w := range weights {
+		sum += int(n[i]-'0') * w
+	}
+
+	return sum
+}
+
+// digit returns the value of the digit of n at the position i.
+func digit(n string, i int) int { return int(n[i] - '0') }
+
+// luhnValid reports whether digits of n pass the Luhn check.
+func luhnValid(n string) bool {
+	sum := 0
+
+	for i := len(n) - 1; i >= 0; i-- {
+		d := digit(n, i)
+
+		if (len(n)-1-i)%2 == 1 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+
+		sum += d
+	}
+
+	return sum%10 == 0
+}
+
+// mod11x10Valid reports whether digits of n pass ISO 7064 MOD 11,10 check.
+func mod11x10Valid(n string) bool {
+	product := 10
+
+	for i := 0; i < len(n)-1; i++ {
+		sum := (digit(n, i) + product) % 10
+		if sum == 0 {
+			sum = 10
+		}
+
+		product = (2 * sum) % 11
+	}
+
+	return (11-product)%10 == digit(n, len(n)-1)
+}
+
+func vatAT(n string) bool {
+	if len(n) != 9 || n[0] != 'U' || !isDigits(n[1:]) {
+		return false
+	}
+
+	n = n[1:]
+	sum := 0
+
+	for i := 0; i < 7; i++ {
+		d := digit(n, i)
+		if i%2 == 1 {
+			d = d*2/10 + d*2%10
+		}
+
+		sum += d
+	}
+
+	return (10-(sum+4)%10)%10 == digit(n, 7)
+}
+
+func vatBE(n string) bool {
+	if len(n) != 10 || !isDigits(n) || (n[0] != '0' && n[0] != '1') {
+		return false
+	}
+
+	base, _ := strconv.Atoi(n[:8])
+	check, _ := strconv.Atoi(n[8:])
+
+	return 97-base%97 == check
+}
+
+func vatBG(n string) bool {
+	if !isDigits(n) {
+		return false
+	}
+
+	switch len(n) {
+	case 9:
+		r := weightedSum(n, 1, 2, 3, 4, 5, 6, 7, 8) % 11
+		if r == 10 {
+			r = weightedSum(n, 3, 4, 5, 6, 7, 8, 9, 10) % 11 % 10
+		}
+
+		return r == digit(n, 8)
+	case 10:
+		// Personal identification number of Bulgarian citizens.
+		if weightedSum(n, 2, 4, 8, 5, 10, 9, 7, 3, 6)%11%10 == digit(n, 9) {
+			return true
+		}
+
+		// Personal identification number of foreigners.
+		if weightedSum(n, 21, 19, 17, 13, 11, 9, 7, 3, 1)%10 == digit(n, 9) {
+			return true
+		}
+
+		// Other taxable entities.
+		r := 11 - weightedSum(n, 4, 3, 2, 7, 6, 5, 4, 3, 2)%11
+
+		return r != 10 && r%11 == digit(n, 9)
+	default:
+		return false
+	}
+}
+
+func vatCY(n string) bool {
+	if len(n) != 9 || !isDigits(n[:8]) || !isUpperLetters(n[8:]) || n[:2] == "12" {
+		return false
+	}
+
+	if n[0] != '0' && n[0] != '1' && n[0] != '3' && n[0] != '4' && n[0] != '5' && n[0] != '9' {
+		return false
+	}
+
+	odd := [10]int{1, 0, 5, 7, 9, 13, 15, 17, 19, 21}
+	sum := 0
+
+	for i := 0; i < 8; i++ {
+		if i%2 == 0 {
+			sum += odd[digit(n, i)]
+		} else {
+			sum += digit(n, i)
+		}
+	}
+
+	return byte('A'+sum%26) == n[8]
+}
+
+func vatCZ(n string) bool {
+	if !isDigits(n) {
+		return false
+	}
+
+	switch len(n) {
+	case 8:
+		if n[0] == '9' {
+			return false
+		}
+
+		return (11-weightedSum(n, 8, 7, 6, 5, 4, 3, 2)%11)%10 == digit(n, 7)
+	case 9:
+		// Individuals with birth numbers issued before 1954.
+		return true
+	case 10:
+		// Individuals, the birth number is divisible by 11.
+		v, _ := strconv.ParseInt(n, 10, 64)
+
+		return v%11 == 0
+	default:
+		return false
+	}
+}
+
+func vatDE(n string) bool {
+	return len(n) == 9 && isDigits(n) && n[0] != '0' && mod11x10Valid(n)
+}
+
+func vatDK(n string) bool {
+	return len(n) == 8 && isDigits(n) && n[0] != '0' && weightedSum(n, 2, 7, 6, 5, 4, 3, 2, 1)%11 == 0
+}
+
+func vatEE(n string) bool {
+	if len(n) != 9 || !isDigits(n) || n[:2] != "10" {
+		return false
+	}
+
+	return (10-weightedSum(n, 3, 7, 1, 3, 7, 1, 3, 7)%10)%10 == digit(n, 8)
+}
+
+func vatEL(n string) bool {
+	if len(n) != 9 || !isDigits(n) {
+		return false
+	}
+
+	return weightedSum(n, 256, 128, 64, 32, 16, 8, 4, 2)%11%10 == digit(n, 8)
+}
+
+func vatES(n string) bool {
+	if len(n) != 9 || !isDigits(n[1:8]) || !isAlphanumeric(n) {
+		return false
+	}
+
+	const (
+		personLetters = "TRWAGMYFPDXBNJZSQVHLCKE"
+		entityLetters = "JABCDEFGHI"
+	)
+
+	switch first, last := n[0], n[8]; {
+	case first >= '0' && first <= '9':
+		// DNI of Spanish citizens.
+		v, _ := strconv.Atoi(n[:8])
+
+		return personLetters[v%23] == last
+	case strings.IndexByte("XYZ", first) >= 0:
+		// NIE of foreigners, X, Y and Z stand for 0, 1 and 2.
+		v, _ := strconv.Atoi(string('0'+first-'X') + n[1:8])
+
+		return personLetters[v%23] == last
+	case strings.IndexByte("KLM", first) >= 0:
+		// Spanish citizens without DNI.
+		v, _ := strconv.Atoi(n[1:8])
+
+		return personLetters[v%23] == last
+	case strings.IndexByte("ABCDEFGHJNPQRSUVW", first) >= 0:
+		// Legal entities.
+		sum := 0
+
+		for i := 1; i < 8; i++ {
+			d := digit(n, i)
+			if i%2 == 1 {
+				d = d*2/10 + d*2%10
+			}
+
+			sum += d
+		}
+
+		check := (10 - sum%10) % 10
+
+		return last == byte('0'+check) || last == entityLetters[check]
+	default:
+		return false
+	}
+}
+
+func vatFI(n string) bool {
+	if len(n) != 8 || !isDigits(n) {
+		return false
+	}
+
+	r := weightedSum(n, 7, 9, 10, 5, 8, 4, 2) % 11
+
+	return r != 1 && (11-r)%11 == digit(n, 7)
+}
+
+func vatFR(n string) bool {
+	if len(n) != 11 || !isDigits(n[2:]) || !isAlphanumeric(n[:2]) {
+		return false
+	}
+
+	if !luhnValid(n[2:]) && n[2:5] != "356" {
+		// La Poste SIREN numbers 356000000 don't pass the Luhn check.
+		return false
+	}
+
+	if !isDigits(n[:2]) {
+		// New style alphanumeric keys have no published check algorithm.
+		return true
+	}
+
+	siren, _ := strconv.Atoi(n[2:])
+	key, _ := strconv.Atoi(n[:2])
+
+	return (12+3*(siren%97))%97 == key
+}
+
+func vatHR(n string) bool { return len(n) == 11 && isDigits(n) && mod11x10Valid(n) }
+
+func vatHU(n string) bool {
+	if len(n) != 8 || !isDigits(n) {
+		return false
+	}
+
+	return (10-weightedSum(n, 9, 7, 3, 1, 9, 7, 3)%10)%10 == digit(n, 7)
+}
+
+func vatIE(n string) bool {
+	// Old style numbers, e.g. 8Z49289F, are converted to the new style.
+	if len(n) == 8 && isDigits(n[:1]) && strings.IndexByte("ABCDEFGHIJKLMNOPQRSTUVWXYZ+*", n[1]) >= 0 &&
+		isDigits(n[2:7]) && isUpperLetters(n[7:]) {
+		n = "0" + n[2:7] + n[:1] + n[7:]
+	}
+
+	if (len(n) != 8 && len(n) != 9) || !isDigits(n[:7]) || !isUpperLetters(n[7:]) {
+		return false
+	}
+
+	const letters = "WABCDEFGHIJKLMNOPQRSTUV"
+
+	sum := weightedSum(n, 8, 7, 6, 5, 4, 3, 2)
+	if len(n) == 9 && n[8] != 'W' {
+		sum += int(n[8]-'A'+1) * 9
+	}
+
+	return letters[sum%23] == n[7]
+}
+
+func vatIT(n string) bool {
+	if len(n) != 11 || !isDigits(n) || n[:7] == "0000000" {
+		return false
+	}
+
+	return luhnValid(n)
+}
+
+func vatLT(n string) bool {
+	if !isDigits(n) || (len(n) != 9 && len(n) != 12) || n[len(n)-2] != '1' {
+		return false
+	}
+
+	check := func(start int) int {
+		sum := 0
+
+		for i := 0; i < len(n)-1; i++ {
+			sum += digit(n, i) * ((start+i-1)%9 + 1)
+		}
+
+		return sum % 11
+	}
+
+	r := check(1)
+	if r == 10 {
+		r = check(3) % 10
+	}
+
+	return r == digit(n, len(n)-1)
+}
+
+func vatLU(n string) bool {
+	if len(n) != 8 || !isDigits(n) {
+		return false
+	}
+
+	base, _ := strconv.Atoi(n[:6])
+	check, _ := strconv.Atoi(n[6:])
+
+	return base%89 == check
+}
+
+func vatLV(n string) bool {
+	if len(n) != 11 || !isDigits(n) {
+		return false
+	}
+
+	if n[0] <= '3' {
+		// Individuals, the number starts with the birth date DDMMYY.
+		day, _ := strconv.Atoi(n[:2])
+		month, _ := strconv.Atoi(n[2:4])
+
+		return day >= 1 && day <= 31 && month >= 1 && month <= 12
+	}
+
+	r := 3 - weightedSum(n, 9, 1, 4, 8, 3, 10, 2, 5, 7, 6)%11
+	if r < -1 {
+		r += 11
+	}
+
+	return r != -1 && r == digit(n, 10)
+}
+
+func vatMT(n string) bool {
+	if len(n) != 8 || !isDigits(n) || n[0] == '0' {
+		return false
+	}
+
+	check, _ := strconv.Atoi(n[6:])
+
+	return 37-weightedSum(n, 3, 4, 6, 7, 8, 9)%37 == check
+}
+
+func vatNL(n string) bool {
+	if len(n) != 12 || !isDigits(n[:9]) || n[9] != 'B' || !isDigits(n[10:]) {
+		return false
+	}
+
+	// Legal entities use the mod-11 check of the RSIN.
+	if r := weightedSum(n, 9, 8, 7, 6, 5, 4, 3, 2) % 11; r != 10 && r == digit(n, 8) {
+		return true
+	}
+
+	// Sole proprietors use mod-97 check of the whole number since 2020.
+	return mod97("NL"+n) == 1
+}
+
+func vatPL(n string) bool {
+	if len(n) != 10 || !isDigits(n) {
+		return false
+	}
+
+	r := weightedSum(n, 6, 5, 7, 2, 3, 4, 5, 6, 7) % 11
+
+	return r != 10 && r == digit(n, 9)
+}
+
+func vatPT(n string) bool {
+	if len(n) != 9 || !isDigits(n) || n[0] == '0' {
+		return false
+	}
+
+	check := 11 - weightedSum(n, 9, 8, 7, 6, 5, 4, 3, 2)%11
+	if check >= 10 {
+		check = 0
+	}
+
+	return check == digit(n, 8)
+}
+
+func vatRO(n string) bool {
+	if len(n) < 2 || len(n) > 10 || !isDigits(n) || n[0] == '0' {
+		return false
+	}
+
+	n = strings.Repeat("0", 10-len(n)) + n
+
+	return weightedSum(n, 7, 5, 3, 2, 1, 7, 5, 3, 2)*10%11%10 == digit(n, 9)
+}
+
+func vatSE(n string) bool {
+	return len(n) == 12 && isDigits(n) && n[10:] == "01" && luhnValid(n[:10])
+}
+
+func vatSI(n string) bool {
+	if len(n) != 8 || !isDigits(n) || n[0] == '0' {
+		return false
+	}
+
+	check := 11 - weightedSum(n, 8, 7, 6, 5, 4, 3, 2)%11
+	if check == 11 {
+		return false
+	}
+
+	return check%10 == digit(n, 7)
+}
+
+func vatSK(n string) bool {
+	if len(n) != 10 || !isDigits(n) || n[0] == '0' || strings.IndexByte("234789", n[2]) < 0 {
+		return false
+	}
+
+	v, _ := strconv.ParseInt(n, 10, 64)
+
+	return v%11 == 0
+}
+
+func vatXI(n string) bool {
+	// Government departments and health authorities.
+	if len(n) == 5 && isDigits(n[2:]) {
+		v, _ := strconv.Atoi(n[2:])
+
+		return (n[:2] == "GD" && v < 500) || (n[:2] == "HA" && v >= 500)
+	}
+
+	if (len(n) != 9 && len(n) != 12) || !isDigits(n) {
+		return false
+	}
+
+	check, _ := strconv.Atoi(n[7:9])
+	sum := weightedSum(n, 8, 7, 6, 5, 4, 3, 2) + check
+
+	return sum%97 == 0 || (sum+55)%97 == 0
+}
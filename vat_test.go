@@ -0,0 +1,169 @@
+package isocodes
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestParseVATNumber(t *testing.T) {
+	type tcase struct {
+		input   string
+		want    VATNumber
+		country CountryCode
+		wantErr error
+	}
+
+	tests := map[string]tcase{
+		"AT":           {"ATU13585627", "ATU13585627", AT, nil},
+		"BE":           {"BE 0403.019.261", "BE0403019261", BE, nil},
+		"BEShort":      {"BE403019261", "BE0403019261", BE, nil},
+		"BG":           {"BG 175 074 752", "BG175074752", BG, nil},
+		"CY":           {"CY-10259033P", "CY10259033P", CY, nil},
+		"CZ":           {"CZ25123891", "CZ25123891", CZ, nil},
+		"DEComma":      {"DE 136,695 976", "", DE, ErrInvalidVATNumber},
+		"DESpaces":     {"de 136 695 976", "DE136695976", DE, nil},
+		"DK":           {"DK13585628", "DK13585628", DK, nil},
+		"EE":           {"EE 100 931 558", "EE100931558", EE, nil},
+		"EL":           {"EL 094259216", "EL094259216", GR, nil},
+		"ESEntity":     {"ES B-58378431", "ESB58378431", ES, nil},
+		"ESPerson":     {"ES54362315K", "ES54362315K", ES, nil},
+		"FI":           {"FI 20774740", "FI20774740", FI, nil},
+		"FR":           {"Fr 40 303 265 045", "FR40303265045", FR, nil},
+		"HR":           {"HR33392005961", "HR33392005961", HR, nil},
+		"HU":           {"HU12892312", "HU12892312", HU, nil},
+		"IE":           {"IE6433435F", "IE6433435F", IE, nil},
+		"IEOld":        {"IE8Z49289F", "IE8Z49289F", IE, nil},
+		"IT":           {"IT00743110157", "IT00743110157", IT, nil},
+		"LT":           {"LT119511515", "LT119511515", LT, nil},
+		"LTLong":       {"LT100001919017", "LT100001919017", LT, nil},
+		"LU":           {"LU15027442", "LU15027442", LU, nil},
+		"LV":           {"LV40003521600", "LV40003521600", LV, nil},
+		"MT":           {"MT11679112", "MT11679112", MT, nil},
+		"NL":           {"NL004495445B01", "NL004495445B01", NL, nil},
+		"PL":           {"PL8567346215", "PL8567346215", PL, nil},
+		"PT":           {"PT501964843", "PT501964843", PT, nil},
+		"RO":           {"RO18547290", "RO18547290", RO, nil},
+		"SE":           {"SE123456789701", "SE123456789701", SE, nil},
+		"SI":           {"SI50223054", "SI50223054", SI, nil},
+		"SK":           {"SK2022749619", "SK2022749619", SK, nil},
+		"XI":           {"XI 980 7806 84", "XI980780684", GB, nil},
+		"DEChecksum":   {"DE136695977", "", DE, ErrInvalidVATNumber},
+		"ATNoU":        {"AT13585627", "", AT, ErrInvalidVATNumber},
+		"PLChecksum":   {"PL8567346216", "", PL, ErrInvalidVATNumber},
+		"GreecePrefix": {"GR094259216", "", GR, ErrUnsupportedVATPrefix},
+		"NonEU":        {"CHE123456789", "", CH, ErrUnsupportedVATPrefix},
+		"GB":           {"GB980780684", "", GB, ErrUnsupportedVATPrefix},
+		"Short":        {"DE", "", DE, ErrInvalidVATNumber},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseVATNumber(tc.input)
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Errorf("ParseVATNumber() error = %v, wantErr %v", err, tc.wantErr)
+				}
+
+				return
+			}
+
+			if err != nil || got != tc.want {
+				t.Errorf("ParseVATNumber() got = %v, %v, want %v", got, err, tc.want)
+			}
+
+			if got.Country() != tc.country {
+				t.Errorf("Country() got = %v, want %v", got.Country(), tc.country)
+			}
+		})
+	}
+}
+
+func TestValidateVATNumber(t *testing.T) {
+	got, err := ValidateVATNumber("EL094259216")
+	if err != nil || got != GR {
+		t.Errorf("ValidateVATNumber() got = %v, %v, want %v", got, err, GR)
+	}
+
+	if _, err := ValidateVATNumber("EL094259217"); !errors.Is(err, ErrInvalidVATNumber) {
+		t.Errorf("ValidateVATNumber() error = %v, wantErr %v", err, ErrInvalidVATNumber)
+	}
+
+	if got := len(VATPrefixes()); got != 28 {
+		t.Errorf("VATPrefixes() got = %v prefixes, want 28", got)
+	}
+}
+
+func TestCheckVATNumber(t *testing.T) {
+	calls := 0
+	stub := VATCheckerFunc(func(_ context.Context, vat VATNumber) (VATCheckResult, error) {
+		calls++
+
+		return VATCheckResult{Valid: vat == "DE136695976", Name: "Test GmbH"}, nil
+	})
+
+	got, err := CheckVATNumber(context.Background(), stub, "DE 136 695 976")
+	if err != nil || !got.Valid || got.Name != "Test GmbH" {
+		t.Errorf("CheckVATNumber() got = %+v, %v", got, err)
+	}
+
+	if _, err := CheckVATNumber(context.Background(), stub, "DE136695977"); !errors.Is(err, ErrInvalidVATNumber) {
+		t.Errorf("CheckVATNumber() error = %v, wantErr %v", err, ErrInvalidVATNumber)
+	}
+
+	if calls != 1 {
+		t.Errorf("CheckVAT() called %v times, want 1", calls)
+	}
+}
+
+func TestVIESChecker(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			CountryCode string `json:"countryCode"`
+			VATNumber   string `json:"vatNumber"`
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		if req.CountryCode == "EL" && req.VATNumber == "094259216" {
+			_, _ = w.Write([]byte(`{"valid":true,"name":"ACME","address":"Athens"}`))
+
+			return
+		}
+
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	checker := VIESChecker{Client: server.Client(), URL: server.URL}
+
+	got, err := checker.CheckVAT(context.Background(), "EL094259216")
+	if err != nil || got != (VATCheckResult{Valid: true, Name: "ACME", Address: "Athens"}) {
+		t.Errorf("CheckVAT() got = %+v, %v", got, err)
+	}
+
+	if _, err := checker.CheckVAT(context.Background(), "DE136695976"); !errors.Is(err, ErrVATCheck) {
+		t.Errorf("CheckVAT() error = %v, wantErr %v", err, ErrVATCheck)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), -time.Second)
+	defer cancel()
+
+	_, err = checker.CheckVAT(ctx, "EL094259216")
+	if !errors.Is(err, ErrVATCheck) || !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("CheckVAT() error = %v, wantErr %v and %v", err, ErrVATCheck, context.DeadlineExceeded)
+	}
+
+	var checkErr *VATCheckError
+	if !errors.As(err, &checkErr) {
+		t.Errorf("CheckVAT() error = %#v, want *VATCheckError", err)
+	}
+}